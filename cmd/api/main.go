@@ -11,16 +11,27 @@
 package main
 
 import (
+	"context"
 	"log"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/smtp"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"game-scores/ent"
+	"game-scores/internal/auth"
+	"game-scores/internal/events"
+	"game-scores/internal/mail"
 
 	handler "game-scores/internal/handlers"
 	api_middleware "game-scores/internal/middleware"
 
+	"entgo.io/ent/dialect"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	_ "github.com/lib/pq"
@@ -35,21 +46,40 @@ func main() {
 
 	/* Environmental variable loading ********************************************/
 
-	// Load jwt secret key from environment variable
-	jwtSecret, ok := (os.LookupEnv("JWT_SECRET_KEY"))
-	if !ok {
-		log.Fatal("JWT_SECRET_KEY environment variable not set")
-	}
+	// Load the JWT signing keys from the PEM files listed in the environment variable
+	keys := loadKeySet()
 	// Load database connection string from environment variable
 	connStr, ok := (os.LookupEnv("DB_SOURCE"))
 	if !ok {
 		log.Fatal("DB_SOURCE environment variable not set")
 	}
+	// Load the token lifetimes, e.g. "15m" or "720h"
+	accessTokenTTL := durationFromEnv("ACCESS_TOKEN_TTL", auth.DefaultAccessTokenTTL)
+	refreshTokenTTL := durationFromEnv("REFRESH_TOKEN_TTL", auth.DefaultRefreshTokenTTL)
+	// Load how old the timestamp of a signed score submission may be
+	signatureMaxAge := durationFromEnv("SIGNATURE_MAX_AGE", api_middleware.DefaultSignatureMaxAge)
+	// Load the mailer, and the address the links of the emails point to
+	mailer := loadMailer()
+	publicURL := stringFromEnv("PUBLIC_URL", "http://localhost:8080")
+	emailVerificationTTL := durationFromEnv("EMAIL_VERIFICATION_TTL", auth.DefaultEmailVerificationTTL)
+	passwordResetTTL := durationFromEnv("PASSWORD_RESET_TTL", auth.DefaultPasswordResetTTL)
+	// Load the argon2id parameters of new password hashes, older hashes are upgraded on login
+	passwords := auth.NewArgon2idHasher(loadArgon2Params())
+	// Load the name shown in authenticator apps, and how long users have to enter their TOTP code
+	totpIssuer := stringFromEnv("TOTP_ISSUER", "Game Scores")
+	twoFactorChallengeTTL := durationFromEnv("TWO_FACTOR_CHALLENGE_TTL", auth.DefaultTwoFactorChallengeTTL)
+	// Load the OpenID Connect providers users log in with, and how long they have to log in there
+	oidcProviders := loadOIDCProviders(publicURL)
+	oidcLoginTTL := durationFromEnv("OIDC_LOGIN_TTL", auth.DefaultOIDCLoginTTL)
+	// Load the failed logins locking out a client IP, 0 disables the IP lockout
+	ipLockoutThreshold := intFromEnv("LOGIN_IP_LOCKOUT_THRESHOLD", auth.DefaultLoginIPLockoutThreshold, 0, 1_000_000)
+	// Load the reverse proxies trusted to forward the client IP, none by default
+	trustedProxies := loadTrustedProxies()
 
 	/* Database Init ************************************************************/
 
 	// Connect to the database
-	db, err := ent.Open("postgres", connStr)
+	db, err := ent.Open(dialect.Postgres, connStr)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -62,16 +92,61 @@ func main() {
 
 	r := chi.NewRouter()
 
+	// Read the client IP forwarded by trusted proxies (must go first, so everything sees it)
+	r.Use(api_middleware.RealIP(trustedProxies))
+
 	// Add Telemetry middleware (must go before the logger, to wrap everything)
 	r.Use(api_middleware.Telemetry)
 
 	// Middleware logger from chi to see simple console logs
 	r.Use(middleware.Logger)
 
+	// Revoked access tokens are kept in the database, with a cache in front of it
+	revocations := auth.NewRevocationStore(db, auth.DefaultRevocationCacheSize, auth.DefaultRevocationCacheTTL)
+	go purgeExpiredRevocations(revocations)
+
+	// Games with a signing secret only accept signed score submissions, each nonce once
+	signedRequests := api_middleware.SignedRequestMiddleware(db, signatureMaxAge)
+	go purgeExpiredNonces(db)
+
+	// Failed logins delay the next attempts, and too many lock out the username or client IP
+	logins := auth.NewLoginLimiter(db)
+	logins.IPLockoutThreshold = ipLockoutThreshold
+	go purgeExpiredLoginAttempts(logins)
+
 	// Initialize handlers with dependencies
-	userHandler := &handler.UserHandler{Database: db, JWTSecret: []byte(jwtSecret)}
+	userHandler := &handler.UserHandler{
+		Database:        db,
+		Keys:            keys,
+		AccessTokenTTL:  accessTokenTTL,
+		RefreshTokenTTL: refreshTokenTTL,
+		Revocations:     revocations,
+		Passwords:       passwords,
+
+		Mailer:               mailer,
+		PublicURL:            publicURL,
+		EmailVerificationTTL: emailVerificationTTL,
+		PasswordResetTTL:     passwordResetTTL,
+		Logins:               logins,
+
+		TOTPIssuer:            totpIssuer,
+		TwoFactorChallengeTTL: twoFactorChallengeTTL,
+
+		OIDCProviders: oidcProviders,
+		OIDCLoginTTL:  oidcLoginTTL,
+	}
 	gameHandler := &handler.GameHandler{Database: db}
-	gameScoresHandler := &handler.GameScoresHandler{Database: db}
+	gameScoresHandler := &handler.GameScoresHandler{
+		Database: db,
+		Dialect:  dialect.Postgres,
+		Events:   events.NewBroker(events.DefaultHistory),
+	}
+	seasonHandler := &handler.SeasonHandler{Database: db}
+	serverKeyHandler := &handler.ServerKeyHandler{Database: db}
+	jwksHandler := &handler.JWKSHandler{Keys: keys}
+
+	// Publish the public keys, so other services can verify player tokens
+	r.Get("/.well-known/jwks.json", jwksHandler.ServeJWKS)
 
 	r.Get("/ping", func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("Server is running!"))
@@ -79,9 +154,21 @@ func main() {
 
 	r.Post("/register", userHandler.Register)
 	r.Post("/login", userHandler.Login)
+	r.Post("/login/2fa", userHandler.LoginTwoFactor)
+	r.Get("/auth/{provider}/start", userHandler.StartOIDCLogin)
+	r.Get("/auth/{provider}/callback", userHandler.FinishOIDCLogin)
+	r.Post("/token/refresh", userHandler.RefreshToken)
+	r.Post("/logout", userHandler.Logout)
+	r.Get("/verify-email", userHandler.VerifyEmail)
+	r.Post("/password/forgot", userHandler.ForgotPassword)
+	r.Post("/password/reset", userHandler.ResetPassword)
 	r.Get("/games", gameHandler.ListGames)
 	r.Get("/games/{gameID}/scores", gameScoresHandler.ListGameScores)
+	r.Get("/games/{gameID}/scores/users/{username}", gameScoresHandler.GetPlayerRank)
+	r.Get("/games/{gameID}/scores/stream", gameScoresHandler.StreamGameScores)
 	r.Get("/games/{gameID}/statistics", gameScoresHandler.ListGameScoreStatistics)
+	r.Get("/games/{gameID}/seasons", seasonHandler.ListSeasons)
+	r.Get("/games/{gameID}/seasons/{seasonID}/scores", gameScoresHandler.ListSeasonScores)
 
 	// Add Prometheus metrics endpoint
 	r.Handle("/metrics", promhttp.Handler())
@@ -89,11 +176,63 @@ func main() {
 	r.Group(func(r chi.Router) {
 
 		// Protected routes that require authentication
-		r.Use(api_middleware.AuthMiddleware([]byte(jwtSecret)))
+		r.Use(api_middleware.AuthMiddleware(keys, revocations))
+
+		r.Get("/games/{gameID}/scores/me", gameScoresHandler.GetMyRank)
+		r.Get("/games/{gameID}/scores/me/history", gameScoresHandler.ListMyScoreHistory)
+		r.Get("/roles", userHandler.ListRoles)
+		r.Post("/verify-email", userHandler.ResendVerificationEmail)
+		r.Put("/users/me/password", userHandler.ChangePassword)
+		r.Put("/users/me/email", userHandler.ChangeEmail)
+		r.Delete("/users/me", userHandler.DeleteAccount)
+		r.Post("/users/me/identities/{provider}", userHandler.LinkOIDCAccount)
+		r.Post("/users/me/2fa", userHandler.EnrollTwoFactor)
+		r.Post("/users/me/2fa/confirm", userHandler.ConfirmTwoFactor)
+		r.Delete("/users/me/2fa", userHandler.DisableTwoFactor)
+		r.Post("/users/me/2fa/recovery-codes", userHandler.RegenerateRecoveryCodes)
+
+		// Routes that also require a permission of the user's role
+		requirePermission := api_middleware.RequirePermission
+
+		r.With(requirePermission(auth.PermissionCreateGames)).Post("/games", gameHandler.AddGame)
+		r.With(requirePermission(auth.PermissionBanUsers)).Post("/users/{username}/revoke-tokens", userHandler.RevokeUserTokens)
+		r.With(requirePermission(auth.PermissionBanUsers)).Post("/users/{username}/ban", userHandler.BanUser)
+		r.With(requirePermission(auth.PermissionBanUsers)).Delete("/users/{username}/ban", userHandler.UnbanUser)
+		r.With(requirePermission(auth.PermissionManageRoles)).Put("/users/{username}/role", userHandler.SetUserRole)
+		r.With(requirePermission(auth.PermissionUnlockUsers)).Get("/login-attempts", userHandler.ListLoginAttempts)
+		r.With(requirePermission(auth.PermissionUnlockUsers)).Delete("/login-attempts", userHandler.ClearLoginAttempts)
+
+		// Routes of a single game, also open to its owner and maintainers
+		requireGamePermission := func(permissions ...string) func(http.Handler) http.Handler {
+			return api_middleware.RequireGamePermission(db, permissions...)
+		}
+
+		r.With(requireGamePermission(auth.PermissionManageGames)).Patch("/games/{gameID}", gameHandler.UpdateGame)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Post("/games/{gameID}/seasons", seasonHandler.AddSeason)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Post("/games/{gameID}/signing-secret", gameHandler.RotateSigningSecret)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Delete("/games/{gameID}/signing-secret", gameHandler.RemoveSigningSecret)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Post("/games/{gameID}/server-keys", serverKeyHandler.AddServerKey)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Get("/games/{gameID}/server-keys", serverKeyHandler.ListServerKeys)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Delete("/games/{gameID}/server-keys/{keyID}", serverKeyHandler.RevokeServerKey)
+		r.With(requireGamePermission(auth.PermissionManageGames)).Get("/games/{gameID}/maintainers", gameHandler.ListMaintainers)
+		r.With(requireGamePermission(auth.PermissionManageMaintainers)).Put("/games/{gameID}/maintainers/{username}", gameHandler.AddMaintainer)
+		r.With(requireGamePermission(auth.PermissionManageMaintainers)).Delete("/games/{gameID}/maintainers/{username}", gameHandler.RemoveMaintainer)
+		r.With(requireGamePermission(auth.PermissionManageMaintainers)).Put("/games/{gameID}/owner", gameHandler.TransferOwnership)
+		r.With(requireGamePermission(auth.PermissionModerateScores)).Delete("/games/{gameID}/scores/users/{username}", gameScoresHandler.RemovePlayerScore)
+	})
+
+	r.Group(func(r chi.Router) {
 
-		r.Post("/games", gameHandler.AddGame)
-		r.Put("/games/{gameID}/scores", gameScoresHandler.UpdateGameScore)
+		// Routes shared by players and game servers, which authenticate with a server key
+		r.Use(api_middleware.AuthOrAPIKeyMiddleware(keys, revocations, db))
+
+		r.With(signedRequests).Put("/games/{gameID}/scores", gameScoresHandler.UpdateGameScore)
+		r.With(signedRequests).Post("/games/{gameID}/scores/increment", gameScoresHandler.IncrementGameScore)
 		r.Post("/games/{gameID}/join", gameScoresHandler.JoinGame)
+
+		// Moderators read the history of any player, game servers and maintainers the history of their game's players
+		r.With(api_middleware.RequireGamePermission(db, auth.PermissionModerateScores, auth.PermissionReadLeaderboard)).
+			Get("/games/{gameID}/scores/users/{username}/history", gameScoresHandler.ListPlayerScoreHistory)
 	})
 
 	// Start the server and listen on port 8080
@@ -103,3 +242,199 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// durationFromEnv reads a duration such as "15m" from an environment variable,
+// falling back to def when it is not set.
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Fatalf("%s must be a positive duration, e.g. 15m", name)
+	}
+	return d
+}
+
+// purgeExpiredRevocations periodically deletes the revocations of access tokens
+// that have expired by themselves, so the revocation table does not grow forever.
+func purgeExpiredRevocations(revocations *auth.RevocationStore) {
+	for range time.Tick(time.Hour) {
+		purged, err := revocations.PurgeExpired(context.Background())
+		if err != nil {
+			slog.Error("Failed to purge expired token revocations", "error", err)
+			continue
+		}
+		slog.Info("Purged expired token revocations", "count", purged)
+	}
+}
+
+// purgeExpiredLoginAttempts periodically deletes the failed logins that are too
+// old to be inspected.
+func purgeExpiredLoginAttempts(logins *auth.LoginLimiter) {
+	for range time.Tick(time.Hour) {
+		purged, err := logins.PurgeExpired(context.Background())
+		if err != nil {
+			slog.Error("Failed to purge expired login attempts", "error", err)
+			continue
+		}
+		slog.Info("Purged expired login attempts", "count", purged)
+	}
+}
+
+// purgeExpiredNonces periodically deletes the nonces of signed score submissions
+// whose timestamps are no longer accepted.
+func purgeExpiredNonces(db *ent.Client) {
+	for range time.Tick(time.Hour) {
+		purged, err := api_middleware.PurgeExpiredNonces(context.Background(), db)
+		if err != nil {
+			slog.Error("Failed to purge expired request nonces", "error", err)
+			continue
+		}
+		slog.Info("Purged expired request nonces", "count", purged)
+	}
+}
+
+// loadKeySet loads the JWT signing keys from the comma separated PEM files in
+// JWT_SIGNING_KEYS, the first one being the current signing key. Without it the
+// API does not start, unless JWT_EPHEMERAL_KEY=true asks for an ephemeral key,
+// which is only fit for development.
+func loadKeySet() *auth.KeySet {
+	issuer := stringFromEnv("JWT_ISSUER", auth.DefaultIssuer)
+	audience := stringFromEnv("JWT_AUDIENCE", auth.DefaultAudience)
+
+	paths, ok := os.LookupEnv("JWT_SIGNING_KEYS")
+	if !ok || paths == "" {
+		ephemeral, err := strconv.ParseBool(stringFromEnv("JWT_EPHEMERAL_KEY", "false"))
+		if err != nil {
+			log.Fatal("JWT_EPHEMERAL_KEY must be true or false")
+		}
+		if !ephemeral {
+			log.Fatal("JWT_SIGNING_KEYS environment variable not set, set JWT_EPHEMERAL_KEY=true to sign tokens with an ephemeral key in development")
+		}
+		slog.Warn("JWT_SIGNING_KEYS not set, signing tokens with an ephemeral key that is lost on restart")
+		keys, err := auth.GenerateKeySet(issuer, audience)
+		if err != nil {
+			log.Fatalf("Failed to generate a signing key: %v", err)
+		}
+		return keys
+	}
+
+	keys, err := auth.LoadKeySet(strings.Split(paths, ","), issuer, audience)
+	if err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	return keys
+}
+
+// intFromEnv reads an integer from an environment variable, falling back to def
+// when it is not set, and exits if it is not between min and max.
+func intFromEnv(name string, def, min, max int) int {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		log.Fatalf("%s must be an integer between %d and %d", name, min, max)
+	}
+	return n
+}
+
+// loadTrustedProxies reads the reverse proxies trusted to forward the client IP
+// from TRUSTED_PROXIES, a comma separated list of IP addresses and CIDR ranges.
+func loadTrustedProxies() []*net.IPNet {
+	proxies, err := api_middleware.ParseTrustedProxies(stringFromEnv("TRUSTED_PROXIES", ""))
+	if err != nil {
+		log.Fatalf("TRUSTED_PROXIES must list IP addresses and CIDR ranges: %v", err)
+	}
+	if len(proxies) > 0 {
+		slog.Info("Trusted proxies configured", "proxies", proxies)
+	}
+	return proxies
+}
+
+// loadArgon2Params reads the argon2id parameters of new password hashes from
+// ARGON2_MEMORY (in KiB), ARGON2_ITERATIONS and ARGON2_PARALLELISM, falling
+// back to the defaults.
+func loadArgon2Params() auth.Argon2Params {
+	params := auth.DefaultArgon2Params
+	params.Memory = uint32(intFromEnv("ARGON2_MEMORY", int(params.Memory), 1, 4*1024*1024)) // Up to 4 GiB
+	params.Iterations = uint32(intFromEnv("ARGON2_ITERATIONS", int(params.Iterations), 1, 100))
+	params.Parallelism = uint8(intFromEnv("ARGON2_PARALLELISM", int(params.Parallelism), 1, 255))
+	return params
+}
+
+// loadOIDCProviders returns the OpenID Connect providers named in the comma
+// separated OIDC_PROVIDERS, e.g. "xbox,steam", each configured by
+// OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID and OIDC_<NAME>_CLIENT_SECRET.
+// OIDC_<NAME>_TRUST_EMAIL=true links new accounts to the user of their verified
+// email. Providers redirect users back to the API at PUBLIC_URL.
+func loadOIDCProviders(publicURL string) map[string]*auth.OIDCProvider {
+	providers := make(map[string]*auth.OIDCProvider)
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !oidcProviderName.MatchString(name) {
+			log.Fatalf("OIDC provider names must be lowercase letters, digits and dashes, got %q", name)
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		issuer, clientID := os.Getenv(prefix+"ISSUER"), os.Getenv(prefix+"CLIENT_ID")
+		if issuer == "" || clientID == "" {
+			log.Fatalf("%sISSUER and %sCLIENT_ID must be set for the OIDC provider %s", prefix, prefix, name)
+		}
+		redirectURL := strings.TrimSuffix(publicURL, "/") + "/auth/" + name + "/callback"
+		provider := auth.NewOIDCProvider(name, issuer, clientID, os.Getenv(prefix+"CLIENT_SECRET"), redirectURL)
+		trustEmail, err := strconv.ParseBool(stringFromEnv(prefix+"TRUST_EMAIL", "false"))
+		if err != nil {
+			log.Fatalf("%sTRUST_EMAIL must be true or false", prefix)
+		}
+		provider.TrustEmail = trustEmail
+		providers[name] = provider
+		slog.Info("OIDC provider configured", "provider", name, "issuer", issuer, "trust_email", trustEmail)
+	}
+	return providers
+}
+
+// oidcProviderName matches the names of OIDC providers, part of their routes.
+var oidcProviderName = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// stringFromEnv reads an environment variable, falling back to def when it is not set.
+func stringFromEnv(name, def string) string {
+	if value, ok := os.LookupEnv(name); ok && value != "" {
+		return value
+	}
+	return def
+}
+
+// loadMailer returns the mailer selected by MAILER: "smtp" sends emails through
+// SMTP_ADDR, "file" writes them to MAIL_DIR, and "log", the default, logs them.
+func loadMailer() mail.Mailer {
+	from := stringFromEnv("MAIL_FROM", "Game Scores <no-reply@localhost>")
+
+	switch kind := stringFromEnv("MAILER", "log"); kind {
+	case "smtp":
+		addr, ok := os.LookupEnv("SMTP_ADDR")
+		if !ok || addr == "" {
+			log.Fatal("SMTP_ADDR must be set to send emails through SMTP")
+		}
+		mailer := &mail.SMTPMailer{Addr: addr, From: from}
+		if username := os.Getenv("SMTP_USERNAME"); username != "" {
+			host, _, _ := strings.Cut(addr, ":")
+			mailer.Auth = smtp.PlainAuth("", username, os.Getenv("SMTP_PASSWORD"), host)
+		}
+		return mailer
+	case "file":
+		return &mail.FileMailer{Dir: stringFromEnv("MAIL_DIR", "mail"), From: from}
+	case "log":
+		slog.Warn("MAILER not set to smtp, emails are logged instead of being sent")
+		return mail.LogMailer{}
+	default:
+		log.Fatalf("MAILER must be smtp, file or log, got %q", kind)
+		return nil
+	}
+}
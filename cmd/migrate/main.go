@@ -6,6 +6,12 @@ import (
 	"os"
 
 	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/user"
+	"game-scores/internal/validate"
 
 	_ "github.com/lib/pq"
 )
@@ -27,9 +33,137 @@ func main() {
 	}
 	defer client.Close()
 
-	if err := client.Schema.Create(context.Background()); err != nil {
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	if err := normalizeUsers(ctx, client); err != nil {
+		log.Fatalf("failed normalizing users: %v", err)
+	}
+
+	if err := setUsernameSkeletons(ctx, client); err != nil {
+		log.Fatalf("failed setting username skeletons: %v", err)
+	}
+
+	if err := linkSubmissions(ctx, client); err != nil {
+		log.Fatalf("failed linking score submissions: %v", err)
+	}
+
 	log.Println("Database migration completed successfully.")
 }
+
+// normalizeUsers sets the username key of the users registered before usernames
+// were unique whatever their case, and lowercases their email. Users whose key or
+// email is already taken by another user are left as they are and logged, to be
+// renamed by hand.
+func normalizeUsers(ctx context.Context, client *ent.Client) error {
+	users, err := client.User.Query().Where(user.UsernameKeyIsNil()).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	normalized := 0
+	for _, u := range users {
+		err := client.User.UpdateOne(u).
+			SetUsernameKey(validate.UsernameKey(u.Username)).
+			SetEmail(validate.NormalizeEmail(u.Email)).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			log.Printf("User %s collides with another user once normalized, left as is", u.Username)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		normalized++
+	}
+	if normalized > 0 {
+		log.Printf("Normalized the usernames and emails of %d users.", normalized)
+	}
+	return nil
+}
+
+// setUsernameSkeletons sets the skeleton of the users registered before look-alike
+// usernames were rejected. Users whose skeleton is already taken by another user
+// are left without one and logged, to be renamed by hand.
+func setUsernameSkeletons(ctx context.Context, client *ent.Client) error {
+	users, err := client.User.Query().Where(user.UsernameSkeletonIsNil()).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	set := 0
+	for _, u := range users {
+		err := client.User.UpdateOne(u).
+			SetUsernameSkeleton(validate.UsernameSkeleton(u.Username)).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			log.Printf("User %s looks like another user, left as is", u.Username)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		set++
+	}
+	if set > 0 {
+		log.Printf("Set the username skeletons of %d users.", set)
+	}
+	return nil
+}
+
+// linkSubmissions links the submissions recorded before submissions knew their
+// score to the score they were applied to: the player's score in the season
+// running when they were submitted, or outside of seasons. Submissions made
+// before their score was created, e.g. to a score a moderator removed since,
+// are left unlinked, like the submissions of removed scores.
+func linkSubmissions(ctx context.Context, client *ent.Client) error {
+	scores, err := client.Score.
+		Query().
+		Where(score.Not(score.HasSubmissions())).
+		WithUser().
+		WithGame(func(q *ent.GameQuery) { q.WithSeasons() }).
+		WithSeason().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	linked := 0
+	for _, s := range scores {
+		preds := []predicate.ScoreSubmission{
+			scoresubmission.HasUserWith(user.ID(s.Edges.User.ID)),
+			scoresubmission.HasGameWith(game.ID(s.Edges.Game.ID)),
+			scoresubmission.Not(scoresubmission.HasScore()),
+			scoresubmission.SubmittedAtGTE(s.CreatedAt),
+		}
+		if s.Edges.Season != nil {
+			preds = append(preds,
+				scoresubmission.SubmittedAtGTE(s.Edges.Season.StartsAt),
+				scoresubmission.SubmittedAtLT(s.Edges.Season.EndsAt),
+			)
+		} else {
+			for _, season := range s.Edges.Game.Edges.Seasons {
+				preds = append(preds, scoresubmission.Not(scoresubmission.And(
+					scoresubmission.SubmittedAtGTE(season.StartsAt),
+					scoresubmission.SubmittedAtLT(season.EndsAt),
+				)))
+			}
+		}
+
+		n, err := client.ScoreSubmission.
+			Update().
+			Where(preds...).
+			SetScore(s).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		linked += n
+	}
+	if linked > 0 {
+		log.Printf("Linked %d score submissions to their score.", linked)
+	}
+	return nil
+}
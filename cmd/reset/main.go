@@ -29,22 +29,82 @@ func main() {
 
 	ctx := context.Background()
 
-	// Step 1: Delete all scores
-	// This is done first because scores have foreign keys to users and games.
+	// Step 1: Delete all score submissions and scores
+	// This is done first because they have foreign keys to users and games.
+	deletedSubmissions, err := client.ScoreSubmission.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete score submissions: %v", err)
+	}
+	log.Printf("✅ Deleted %d score submissions.", deletedSubmissions)
+
 	deletedScores, err := client.Score.Delete().Exec(ctx)
 	if err != nil {
 		log.Fatalf("failed to delete scores: %v", err)
 	}
 	log.Printf("✅ Deleted %d scores.", deletedScores)
 
-	// Step 2: Delete all games
+	// Step 2: Delete all server keys, request nonces, seasons and games
+	deletedServerKeys, err := client.ServerKey.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete server keys: %v", err)
+	}
+	log.Printf("✅ Deleted %d server keys.", deletedServerKeys)
+
+	deletedNonces, err := client.RequestNonce.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete request nonces: %v", err)
+	}
+	log.Printf("✅ Deleted %d request nonces.", deletedNonces)
+
+	deletedSeasons, err := client.Season.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete seasons: %v", err)
+	}
+	log.Printf("✅ Deleted %d seasons.", deletedSeasons)
+
 	deletedGames, err := client.Game.Delete().Exec(ctx)
 	if err != nil {
 		log.Fatalf("failed to delete games: %v", err)
 	}
 	log.Printf("✅ Deleted %d games.", deletedGames)
 
-	// Step 3: Delete all users EXCEPT the admin user
+	// Step 3: Delete all failed logins, sessions, password reset tokens, recovery codes and identities, then all users EXCEPT the admin user
+	deletedAttempts, err := client.LoginAttempt.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete login attempts: %v", err)
+	}
+	log.Printf("✅ Deleted %d login attempts.", deletedAttempts)
+
+	deletedResetTokens, err := client.PasswordResetToken.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete password reset tokens: %v", err)
+	}
+	log.Printf("✅ Deleted %d password reset tokens.", deletedResetTokens)
+
+	deletedRecoveryCodes, err := client.RecoveryCode.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete recovery codes: %v", err)
+	}
+	log.Printf("✅ Deleted %d recovery codes.", deletedRecoveryCodes)
+
+	deletedIdentities, err := client.Identity.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete identities: %v", err)
+	}
+	log.Printf("✅ Deleted %d identities.", deletedIdentities)
+
+	deletedTokens, err := client.RefreshToken.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete refresh tokens: %v", err)
+	}
+	log.Printf("✅ Deleted %d refresh tokens.", deletedTokens)
+
+	deletedRevocations, err := client.RevokedToken.Delete().Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to delete revoked tokens: %v", err)
+	}
+	log.Printf("✅ Deleted %d revoked tokens.", deletedRevocations)
+
 	deletedUsers, err := client.User.
 		Delete().
 		Where(user.UsernameNEQ("admin")). // Use the NEQ (Not Equal) predicate
@@ -54,5 +114,18 @@ func main() {
 	}
 	log.Printf("✅ Deleted %d non-admin users.", deletedUsers)
 
+	// Step 4: Disable the two-factor authentication of the admin user, so it can enroll again
+	err = client.User.
+		Update().
+		Where(user.UsernameEQ("admin")).
+		ClearTotpSecret().
+		SetTotpEnabled(false).
+		SetTotpLastStep(0).
+		Exec(ctx)
+	if err != nil {
+		log.Fatalf("failed to disable the two-factor authentication of the admin user: %v", err)
+	}
+	log.Println("✅ Disabled the two-factor authentication of the admin user.")
+
 	log.Println("--- ✅ Database Reset Complete. Admin account remains. ---")
 }
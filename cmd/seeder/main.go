@@ -7,9 +7,10 @@ import (
 
 	"game-scores/ent"
 	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	"game-scores/internal/validate"
 
 	_ "github.com/lib/pq"
-	"golang.org/x/crypto/bcrypt"
 )
 
 func main() {
@@ -27,7 +28,7 @@ func main() {
 	adminUsername := "admin"
 
 	// --- Check if admin user already exists ---
-	exists, err := client.User.Query().Where(user.UsernameEQ(adminUsername)).Exist(ctx)
+	exists, err := client.User.Query().Where(user.UsernameKey(validate.UsernameKey(adminUsername))).Exist(ctx)
 	if err != nil {
 		log.Fatalf("failed checking for admin user: %v", err)
 	}
@@ -40,15 +41,19 @@ func main() {
 	// --- Create Admin User ---
 	// WARNING: Not production ready: hardcoded password for seeding purposes
 	adminPassword := "admin123!"
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(adminPassword), bcrypt.DefaultCost)
+	// The API rehashes it on login if it is configured with other argon2id parameters
+	hashedPassword, err := auth.NewArgon2idHasher(auth.DefaultArgon2Params).Hash(adminPassword)
 	if err != nil {
 		log.Fatalf("failed to hash password: %v", err)
 	}
 
 	_, err = client.User.Create().
 		SetUsername(adminUsername).
+		SetUsernameKey(validate.UsernameKey(adminUsername)).
+		SetUsernameSkeleton(validate.UsernameSkeleton(adminUsername)).
 		SetEmail("admin@example.com").
-		SetPasswordHash(string(hashedPassword)).
+		SetEmailVerified(true).
+		SetPasswordHash(hashedPassword).
 		SetRole(user.RoleAdmin). // Set the role to admin
 		Save(ctx)
 
@@ -56,5 +61,5 @@ func main() {
 		log.Fatalf("failed creating admin user: %v", err)
 	}
 
-	log.Println("Admin user created successfully.")
+	log.Println("Admin user created successfully. Its permissions require enrolling two-factor authentication at POST /users/me/2fa.")
 }
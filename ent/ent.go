@@ -7,7 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"game-scores/ent/game"
+	"game-scores/ent/identity"
+	"game-scores/ent/loginattempt"
+	"game-scores/ent/passwordresettoken"
+	"game-scores/ent/recoverycode"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/requestnonce"
+	"game-scores/ent/revokedtoken"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
+	"game-scores/ent/serverkey"
 	"game-scores/ent/user"
 	"reflect"
 	"sync"
@@ -75,9 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			game.Table:  game.ValidColumn,
-			score.Table: score.ValidColumn,
-			user.Table:  user.ValidColumn,
+			game.Table:               game.ValidColumn,
+			identity.Table:           identity.ValidColumn,
+			loginattempt.Table:       loginattempt.ValidColumn,
+			passwordresettoken.Table: passwordresettoken.ValidColumn,
+			recoverycode.Table:       recoverycode.ValidColumn,
+			refreshtoken.Table:       refreshtoken.ValidColumn,
+			requestnonce.Table:       requestnonce.ValidColumn,
+			revokedtoken.Table:       revokedtoken.ValidColumn,
+			score.Table:              score.ValidColumn,
+			scoresubmission.Table:    scoresubmission.ValidColumn,
+			season.Table:             season.ValidColumn,
+			serverkey.Table:          serverkey.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
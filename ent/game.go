@@ -5,10 +5,12 @@ package ent
 import (
 	"fmt"
 	"game-scores/ent/game"
+	"game-scores/ent/user"
 	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
 )
 
 // Game is the model entity for the Game schema.
@@ -20,19 +22,38 @@ type Game struct {
 	Name string `json:"name,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
+	// SortOrder holds the value of the "sort_order" field.
+	SortOrder game.SortOrder `json:"sort_order,omitempty"`
+	// ScorePolicy holds the value of the "score_policy" field.
+	ScorePolicy game.ScorePolicy `json:"score_policy,omitempty"`
+	// SigningSecret holds the value of the "signing_secret" field.
+	SigningSecret string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GameQuery when eager-loading is set.
-	Edges        GameEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges            GameEdges `json:"edges"`
+	user_owned_games *uuid.UUID
+	selectValues     sql.SelectValues
 }
 
 // GameEdges holds the relations/edges for other nodes in the graph.
 type GameEdges struct {
 	// Scores holds the value of the scores edge.
 	Scores []*Score `json:"scores,omitempty"`
+	// Submissions holds the value of the submissions edge.
+	Submissions []*ScoreSubmission `json:"submissions,omitempty"`
+	// Seasons holds the value of the seasons edge.
+	Seasons []*Season `json:"seasons,omitempty"`
+	// ServerKeys holds the value of the server_keys edge.
+	ServerKeys []*ServerKey `json:"server_keys,omitempty"`
+	// RequestNonces holds the value of the request_nonces edge.
+	RequestNonces []*RequestNonce `json:"request_nonces,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Maintainers holds the value of the maintainers edge.
+	Maintainers []*User `json:"maintainers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [7]bool
 }
 
 // ScoresOrErr returns the Scores value or an error if the edge
@@ -44,6 +65,62 @@ func (e GameEdges) ScoresOrErr() ([]*Score, error) {
 	return nil, &NotLoadedError{edge: "scores"}
 }
 
+// SubmissionsOrErr returns the Submissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) SubmissionsOrErr() ([]*ScoreSubmission, error) {
+	if e.loadedTypes[1] {
+		return e.Submissions, nil
+	}
+	return nil, &NotLoadedError{edge: "submissions"}
+}
+
+// SeasonsOrErr returns the Seasons value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) SeasonsOrErr() ([]*Season, error) {
+	if e.loadedTypes[2] {
+		return e.Seasons, nil
+	}
+	return nil, &NotLoadedError{edge: "seasons"}
+}
+
+// ServerKeysOrErr returns the ServerKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) ServerKeysOrErr() ([]*ServerKey, error) {
+	if e.loadedTypes[3] {
+		return e.ServerKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "server_keys"}
+}
+
+// RequestNoncesOrErr returns the RequestNonces value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) RequestNoncesOrErr() ([]*RequestNonce, error) {
+	if e.loadedTypes[4] {
+		return e.RequestNonces, nil
+	}
+	return nil, &NotLoadedError{edge: "request_nonces"}
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GameEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// MaintainersOrErr returns the Maintainers value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) MaintainersOrErr() ([]*User, error) {
+	if e.loadedTypes[6] {
+		return e.Maintainers, nil
+	}
+	return nil, &NotLoadedError{edge: "maintainers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Game) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -51,8 +128,10 @@ func (*Game) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case game.FieldID:
 			values[i] = new(sql.NullInt64)
-		case game.FieldName, game.FieldDescription:
+		case game.FieldName, game.FieldDescription, game.FieldSortOrder, game.FieldScorePolicy, game.FieldSigningSecret:
 			values[i] = new(sql.NullString)
+		case game.ForeignKeys[0]: // user_owned_games
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -86,6 +165,31 @@ func (ga *Game) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ga.Description = value.String
 			}
+		case game.FieldSortOrder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort_order", values[i])
+			} else if value.Valid {
+				ga.SortOrder = game.SortOrder(value.String)
+			}
+		case game.FieldScorePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field score_policy", values[i])
+			} else if value.Valid {
+				ga.ScorePolicy = game.ScorePolicy(value.String)
+			}
+		case game.FieldSigningSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_secret", values[i])
+			} else if value.Valid {
+				ga.SigningSecret = value.String
+			}
+		case game.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_owned_games", values[i])
+			} else if value.Valid {
+				ga.user_owned_games = new(uuid.UUID)
+				*ga.user_owned_games = *value.S.(*uuid.UUID)
+			}
 		default:
 			ga.selectValues.Set(columns[i], values[i])
 		}
@@ -104,6 +208,36 @@ func (ga *Game) QueryScores() *ScoreQuery {
 	return NewGameClient(ga.config).QueryScores(ga)
 }
 
+// QuerySubmissions queries the "submissions" edge of the Game entity.
+func (ga *Game) QuerySubmissions() *ScoreSubmissionQuery {
+	return NewGameClient(ga.config).QuerySubmissions(ga)
+}
+
+// QuerySeasons queries the "seasons" edge of the Game entity.
+func (ga *Game) QuerySeasons() *SeasonQuery {
+	return NewGameClient(ga.config).QuerySeasons(ga)
+}
+
+// QueryServerKeys queries the "server_keys" edge of the Game entity.
+func (ga *Game) QueryServerKeys() *ServerKeyQuery {
+	return NewGameClient(ga.config).QueryServerKeys(ga)
+}
+
+// QueryRequestNonces queries the "request_nonces" edge of the Game entity.
+func (ga *Game) QueryRequestNonces() *RequestNonceQuery {
+	return NewGameClient(ga.config).QueryRequestNonces(ga)
+}
+
+// QueryOwner queries the "owner" edge of the Game entity.
+func (ga *Game) QueryOwner() *UserQuery {
+	return NewGameClient(ga.config).QueryOwner(ga)
+}
+
+// QueryMaintainers queries the "maintainers" edge of the Game entity.
+func (ga *Game) QueryMaintainers() *UserQuery {
+	return NewGameClient(ga.config).QueryMaintainers(ga)
+}
+
 // Update returns a builder for updating this Game.
 // Note that you need to call Game.Unwrap() before calling this method if this Game
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -132,6 +266,14 @@ func (ga *Game) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(ga.Description)
+	builder.WriteString(", ")
+	builder.WriteString("sort_order=")
+	builder.WriteString(fmt.Sprintf("%v", ga.SortOrder))
+	builder.WriteString(", ")
+	builder.WriteString("score_policy=")
+	builder.WriteString(fmt.Sprintf("%v", ga.ScorePolicy))
+	builder.WriteString(", ")
+	builder.WriteString("signing_secret=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
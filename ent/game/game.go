@@ -3,6 +3,8 @@
 package game
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -16,8 +18,26 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldSortOrder holds the string denoting the sort_order field in the database.
+	FieldSortOrder = "sort_order"
+	// FieldScorePolicy holds the string denoting the score_policy field in the database.
+	FieldScorePolicy = "score_policy"
+	// FieldSigningSecret holds the string denoting the signing_secret field in the database.
+	FieldSigningSecret = "signing_secret"
 	// EdgeScores holds the string denoting the scores edge name in mutations.
 	EdgeScores = "scores"
+	// EdgeSubmissions holds the string denoting the submissions edge name in mutations.
+	EdgeSubmissions = "submissions"
+	// EdgeSeasons holds the string denoting the seasons edge name in mutations.
+	EdgeSeasons = "seasons"
+	// EdgeServerKeys holds the string denoting the server_keys edge name in mutations.
+	EdgeServerKeys = "server_keys"
+	// EdgeRequestNonces holds the string denoting the request_nonces edge name in mutations.
+	EdgeRequestNonces = "request_nonces"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeMaintainers holds the string denoting the maintainers edge name in mutations.
+	EdgeMaintainers = "maintainers"
 	// Table holds the table name of the game in the database.
 	Table = "games"
 	// ScoresTable is the table that holds the scores relation/edge.
@@ -27,6 +47,46 @@ const (
 	ScoresInverseTable = "scores"
 	// ScoresColumn is the table column denoting the scores relation/edge.
 	ScoresColumn = "game_scores"
+	// SubmissionsTable is the table that holds the submissions relation/edge.
+	SubmissionsTable = "score_submissions"
+	// SubmissionsInverseTable is the table name for the ScoreSubmission entity.
+	// It exists in this package in order to avoid circular dependency with the "scoresubmission" package.
+	SubmissionsInverseTable = "score_submissions"
+	// SubmissionsColumn is the table column denoting the submissions relation/edge.
+	SubmissionsColumn = "game_submissions"
+	// SeasonsTable is the table that holds the seasons relation/edge.
+	SeasonsTable = "seasons"
+	// SeasonsInverseTable is the table name for the Season entity.
+	// It exists in this package in order to avoid circular dependency with the "season" package.
+	SeasonsInverseTable = "seasons"
+	// SeasonsColumn is the table column denoting the seasons relation/edge.
+	SeasonsColumn = "game_seasons"
+	// ServerKeysTable is the table that holds the server_keys relation/edge.
+	ServerKeysTable = "server_keys"
+	// ServerKeysInverseTable is the table name for the ServerKey entity.
+	// It exists in this package in order to avoid circular dependency with the "serverkey" package.
+	ServerKeysInverseTable = "server_keys"
+	// ServerKeysColumn is the table column denoting the server_keys relation/edge.
+	ServerKeysColumn = "game_server_keys"
+	// RequestNoncesTable is the table that holds the request_nonces relation/edge.
+	RequestNoncesTable = "request_nonces"
+	// RequestNoncesInverseTable is the table name for the RequestNonce entity.
+	// It exists in this package in order to avoid circular dependency with the "requestnonce" package.
+	RequestNoncesInverseTable = "request_nonces"
+	// RequestNoncesColumn is the table column denoting the request_nonces relation/edge.
+	RequestNoncesColumn = "game_request_nonces"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "games"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_owned_games"
+	// MaintainersTable is the table that holds the maintainers relation/edge. The primary key declared below.
+	MaintainersTable = "user_maintained_games"
+	// MaintainersInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	MaintainersInverseTable = "users"
 )
 
 // Columns holds all SQL columns for game fields.
@@ -34,8 +94,23 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldDescription,
+	FieldSortOrder,
+	FieldScorePolicy,
+	FieldSigningSecret,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "games"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_owned_games",
 }
 
+var (
+	// MaintainersPrimaryKey and MaintainersColumn2 are the table columns denoting the
+	// primary key for the maintainers relation (M2M).
+	MaintainersPrimaryKey = []string{"user_id", "game_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -43,6 +118,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -51,6 +131,59 @@ var (
 	NameValidator func(string) error
 )
 
+// SortOrder defines the type for the "sort_order" enum field.
+type SortOrder string
+
+// SortOrderDescending is the default value of the SortOrder enum.
+const DefaultSortOrder = SortOrderDescending
+
+// SortOrder values.
+const (
+	SortOrderDescending SortOrder = "descending"
+	SortOrderAscending  SortOrder = "ascending"
+)
+
+func (so SortOrder) String() string {
+	return string(so)
+}
+
+// SortOrderValidator is a validator for the "sort_order" field enum values. It is called by the builders before save.
+func SortOrderValidator(so SortOrder) error {
+	switch so {
+	case SortOrderDescending, SortOrderAscending:
+		return nil
+	default:
+		return fmt.Errorf("game: invalid enum value for sort_order field: %q", so)
+	}
+}
+
+// ScorePolicy defines the type for the "score_policy" enum field.
+type ScorePolicy string
+
+// ScorePolicyBest is the default value of the ScorePolicy enum.
+const DefaultScorePolicy = ScorePolicyBest
+
+// ScorePolicy values.
+const (
+	ScorePolicyBest       ScorePolicy = "best"
+	ScorePolicyLatest     ScorePolicy = "latest"
+	ScorePolicyCumulative ScorePolicy = "cumulative"
+)
+
+func (sp ScorePolicy) String() string {
+	return string(sp)
+}
+
+// ScorePolicyValidator is a validator for the "score_policy" field enum values. It is called by the builders before save.
+func ScorePolicyValidator(sp ScorePolicy) error {
+	switch sp {
+	case ScorePolicyBest, ScorePolicyLatest, ScorePolicyCumulative:
+		return nil
+	default:
+		return fmt.Errorf("game: invalid enum value for score_policy field: %q", sp)
+	}
+}
+
 // OrderOption defines the ordering options for the Game queries.
 type OrderOption func(*sql.Selector)
 
@@ -69,6 +202,21 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// BySortOrder orders the results by the sort_order field.
+func BySortOrder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSortOrder, opts...).ToFunc()
+}
+
+// ByScorePolicy orders the results by the score_policy field.
+func ByScorePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScorePolicy, opts...).ToFunc()
+}
+
+// BySigningSecret orders the results by the signing_secret field.
+func BySigningSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningSecret, opts...).ToFunc()
+}
+
 // ByScoresCount orders the results by scores count.
 func ByScoresCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -82,6 +230,83 @@ func ByScores(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newScoresStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySubmissionsCount orders the results by submissions count.
+func BySubmissionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubmissionsStep(), opts...)
+	}
+}
+
+// BySubmissions orders the results by submissions terms.
+func BySubmissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubmissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySeasonsCount orders the results by seasons count.
+func BySeasonsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSeasonsStep(), opts...)
+	}
+}
+
+// BySeasons orders the results by seasons terms.
+func BySeasons(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeasonsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByServerKeysCount orders the results by server_keys count.
+func ByServerKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newServerKeysStep(), opts...)
+	}
+}
+
+// ByServerKeys orders the results by server_keys terms.
+func ByServerKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServerKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRequestNoncesCount orders the results by request_nonces count.
+func ByRequestNoncesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRequestNoncesStep(), opts...)
+	}
+}
+
+// ByRequestNonces orders the results by request_nonces terms.
+func ByRequestNonces(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRequestNoncesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMaintainersCount orders the results by maintainers count.
+func ByMaintainersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMaintainersStep(), opts...)
+	}
+}
+
+// ByMaintainers orders the results by maintainers terms.
+func ByMaintainers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMaintainersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newScoresStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -89,3 +314,45 @@ func newScoresStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ScoresTable, ScoresColumn),
 	)
 }
+func newSubmissionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubmissionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubmissionsTable, SubmissionsColumn),
+	)
+}
+func newSeasonsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeasonsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SeasonsTable, SeasonsColumn),
+	)
+}
+func newServerKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ServerKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ServerKeysTable, ServerKeysColumn),
+	)
+}
+func newRequestNoncesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RequestNoncesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RequestNoncesTable, RequestNoncesColumn),
+	)
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
+func newMaintainersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MaintainersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MaintainersTable, MaintainersPrimaryKey...),
+	)
+}
@@ -64,6 +64,11 @@ func Description(v string) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldDescription, v))
 }
 
+// SigningSecret applies equality check predicate on the "signing_secret" field. It's identical to SigningSecretEQ.
+func SigningSecret(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldSigningSecret, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldName, v))
@@ -204,6 +209,121 @@ func DescriptionContainsFold(v string) predicate.Game {
 	return predicate.Game(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v SortOrder) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v SortOrder) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...SortOrder) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...SortOrder) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// ScorePolicyEQ applies the EQ predicate on the "score_policy" field.
+func ScorePolicyEQ(v ScorePolicy) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldScorePolicy, v))
+}
+
+// ScorePolicyNEQ applies the NEQ predicate on the "score_policy" field.
+func ScorePolicyNEQ(v ScorePolicy) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldScorePolicy, v))
+}
+
+// ScorePolicyIn applies the In predicate on the "score_policy" field.
+func ScorePolicyIn(vs ...ScorePolicy) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldScorePolicy, vs...))
+}
+
+// ScorePolicyNotIn applies the NotIn predicate on the "score_policy" field.
+func ScorePolicyNotIn(vs ...ScorePolicy) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldScorePolicy, vs...))
+}
+
+// SigningSecretEQ applies the EQ predicate on the "signing_secret" field.
+func SigningSecretEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldSigningSecret, v))
+}
+
+// SigningSecretNEQ applies the NEQ predicate on the "signing_secret" field.
+func SigningSecretNEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldSigningSecret, v))
+}
+
+// SigningSecretIn applies the In predicate on the "signing_secret" field.
+func SigningSecretIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldSigningSecret, vs...))
+}
+
+// SigningSecretNotIn applies the NotIn predicate on the "signing_secret" field.
+func SigningSecretNotIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldSigningSecret, vs...))
+}
+
+// SigningSecretGT applies the GT predicate on the "signing_secret" field.
+func SigningSecretGT(v string) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldSigningSecret, v))
+}
+
+// SigningSecretGTE applies the GTE predicate on the "signing_secret" field.
+func SigningSecretGTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldSigningSecret, v))
+}
+
+// SigningSecretLT applies the LT predicate on the "signing_secret" field.
+func SigningSecretLT(v string) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldSigningSecret, v))
+}
+
+// SigningSecretLTE applies the LTE predicate on the "signing_secret" field.
+func SigningSecretLTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldSigningSecret, v))
+}
+
+// SigningSecretContains applies the Contains predicate on the "signing_secret" field.
+func SigningSecretContains(v string) predicate.Game {
+	return predicate.Game(sql.FieldContains(FieldSigningSecret, v))
+}
+
+// SigningSecretHasPrefix applies the HasPrefix predicate on the "signing_secret" field.
+func SigningSecretHasPrefix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasPrefix(FieldSigningSecret, v))
+}
+
+// SigningSecretHasSuffix applies the HasSuffix predicate on the "signing_secret" field.
+func SigningSecretHasSuffix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasSuffix(FieldSigningSecret, v))
+}
+
+// SigningSecretIsNil applies the IsNil predicate on the "signing_secret" field.
+func SigningSecretIsNil() predicate.Game {
+	return predicate.Game(sql.FieldIsNull(FieldSigningSecret))
+}
+
+// SigningSecretNotNil applies the NotNil predicate on the "signing_secret" field.
+func SigningSecretNotNil() predicate.Game {
+	return predicate.Game(sql.FieldNotNull(FieldSigningSecret))
+}
+
+// SigningSecretEqualFold applies the EqualFold predicate on the "signing_secret" field.
+func SigningSecretEqualFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldEqualFold(FieldSigningSecret, v))
+}
+
+// SigningSecretContainsFold applies the ContainsFold predicate on the "signing_secret" field.
+func SigningSecretContainsFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldContainsFold(FieldSigningSecret, v))
+}
+
 // HasScores applies the HasEdge predicate on the "scores" edge.
 func HasScores() predicate.Game {
 	return predicate.Game(func(s *sql.Selector) {
@@ -227,6 +347,144 @@ func HasScoresWith(preds ...predicate.Score) predicate.Game {
 	})
 }
 
+// HasSubmissions applies the HasEdge predicate on the "submissions" edge.
+func HasSubmissions() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubmissionsTable, SubmissionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubmissionsWith applies the HasEdge predicate on the "submissions" edge with a given conditions (other predicates).
+func HasSubmissionsWith(preds ...predicate.ScoreSubmission) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newSubmissionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeasons applies the HasEdge predicate on the "seasons" edge.
+func HasSeasons() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SeasonsTable, SeasonsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonsWith applies the HasEdge predicate on the "seasons" edge with a given conditions (other predicates).
+func HasSeasonsWith(preds ...predicate.Season) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newSeasonsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasServerKeys applies the HasEdge predicate on the "server_keys" edge.
+func HasServerKeys() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ServerKeysTable, ServerKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServerKeysWith applies the HasEdge predicate on the "server_keys" edge with a given conditions (other predicates).
+func HasServerKeysWith(preds ...predicate.ServerKey) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newServerKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRequestNonces applies the HasEdge predicate on the "request_nonces" edge.
+func HasRequestNonces() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RequestNoncesTable, RequestNoncesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequestNoncesWith applies the HasEdge predicate on the "request_nonces" edge with a given conditions (other predicates).
+func HasRequestNoncesWith(preds ...predicate.RequestNonce) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newRequestNoncesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMaintainers applies the HasEdge predicate on the "maintainers" edge.
+func HasMaintainers() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MaintainersTable, MaintainersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMaintainersWith applies the HasEdge predicate on the "maintainers" edge with a given conditions (other predicates).
+func HasMaintainersWith(preds ...predicate.User) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newMaintainersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Game) predicate.Game {
 	return predicate.Game(sql.AndPredicates(predicates...))
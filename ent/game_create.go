@@ -7,10 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"game-scores/ent/game"
+	"game-scores/ent/requestnonce"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // GameCreate is the builder for creating a Game entity.
@@ -40,6 +46,48 @@ func (gc *GameCreate) SetNillableDescription(s *string) *GameCreate {
 	return gc
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (gc *GameCreate) SetSortOrder(_go game.SortOrder) *GameCreate {
+	gc.mutation.SetSortOrder(_go)
+	return gc
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (gc *GameCreate) SetNillableSortOrder(_go *game.SortOrder) *GameCreate {
+	if _go != nil {
+		gc.SetSortOrder(*_go)
+	}
+	return gc
+}
+
+// SetScorePolicy sets the "score_policy" field.
+func (gc *GameCreate) SetScorePolicy(gp game.ScorePolicy) *GameCreate {
+	gc.mutation.SetScorePolicy(gp)
+	return gc
+}
+
+// SetNillableScorePolicy sets the "score_policy" field if the given value is not nil.
+func (gc *GameCreate) SetNillableScorePolicy(gp *game.ScorePolicy) *GameCreate {
+	if gp != nil {
+		gc.SetScorePolicy(*gp)
+	}
+	return gc
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (gc *GameCreate) SetSigningSecret(s string) *GameCreate {
+	gc.mutation.SetSigningSecret(s)
+	return gc
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (gc *GameCreate) SetNillableSigningSecret(s *string) *GameCreate {
+	if s != nil {
+		gc.SetSigningSecret(*s)
+	}
+	return gc
+}
+
 // AddScoreIDs adds the "scores" edge to the Score entity by IDs.
 func (gc *GameCreate) AddScoreIDs(ids ...int) *GameCreate {
 	gc.mutation.AddScoreIDs(ids...)
@@ -55,6 +103,100 @@ func (gc *GameCreate) AddScores(s ...*Score) *GameCreate {
 	return gc.AddScoreIDs(ids...)
 }
 
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (gc *GameCreate) AddSubmissionIDs(ids ...int) *GameCreate {
+	gc.mutation.AddSubmissionIDs(ids...)
+	return gc
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (gc *GameCreate) AddSubmissions(s ...*ScoreSubmission) *GameCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gc.AddSubmissionIDs(ids...)
+}
+
+// AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
+func (gc *GameCreate) AddSeasonIDs(ids ...int) *GameCreate {
+	gc.mutation.AddSeasonIDs(ids...)
+	return gc
+}
+
+// AddSeasons adds the "seasons" edges to the Season entity.
+func (gc *GameCreate) AddSeasons(s ...*Season) *GameCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gc.AddSeasonIDs(ids...)
+}
+
+// AddServerKeyIDs adds the "server_keys" edge to the ServerKey entity by IDs.
+func (gc *GameCreate) AddServerKeyIDs(ids ...int) *GameCreate {
+	gc.mutation.AddServerKeyIDs(ids...)
+	return gc
+}
+
+// AddServerKeys adds the "server_keys" edges to the ServerKey entity.
+func (gc *GameCreate) AddServerKeys(s ...*ServerKey) *GameCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gc.AddServerKeyIDs(ids...)
+}
+
+// AddRequestNonceIDs adds the "request_nonces" edge to the RequestNonce entity by IDs.
+func (gc *GameCreate) AddRequestNonceIDs(ids ...int) *GameCreate {
+	gc.mutation.AddRequestNonceIDs(ids...)
+	return gc
+}
+
+// AddRequestNonces adds the "request_nonces" edges to the RequestNonce entity.
+func (gc *GameCreate) AddRequestNonces(r ...*RequestNonce) *GameCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return gc.AddRequestNonceIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (gc *GameCreate) SetOwnerID(id uuid.UUID) *GameCreate {
+	gc.mutation.SetOwnerID(id)
+	return gc
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (gc *GameCreate) SetNillableOwnerID(id *uuid.UUID) *GameCreate {
+	if id != nil {
+		gc = gc.SetOwnerID(*id)
+	}
+	return gc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (gc *GameCreate) SetOwner(u *User) *GameCreate {
+	return gc.SetOwnerID(u.ID)
+}
+
+// AddMaintainerIDs adds the "maintainers" edge to the User entity by IDs.
+func (gc *GameCreate) AddMaintainerIDs(ids ...uuid.UUID) *GameCreate {
+	gc.mutation.AddMaintainerIDs(ids...)
+	return gc
+}
+
+// AddMaintainers adds the "maintainers" edges to the User entity.
+func (gc *GameCreate) AddMaintainers(u ...*User) *GameCreate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gc.AddMaintainerIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (gc *GameCreate) Mutation() *GameMutation {
 	return gc.mutation
@@ -62,6 +204,7 @@ func (gc *GameCreate) Mutation() *GameMutation {
 
 // Save creates the Game in the database.
 func (gc *GameCreate) Save(ctx context.Context) (*Game, error) {
+	gc.defaults()
 	return withHooks(ctx, gc.sqlSave, gc.mutation, gc.hooks)
 }
 
@@ -87,6 +230,18 @@ func (gc *GameCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (gc *GameCreate) defaults() {
+	if _, ok := gc.mutation.SortOrder(); !ok {
+		v := game.DefaultSortOrder
+		gc.mutation.SetSortOrder(v)
+	}
+	if _, ok := gc.mutation.ScorePolicy(); !ok {
+		v := game.DefaultScorePolicy
+		gc.mutation.SetScorePolicy(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (gc *GameCreate) check() error {
 	if _, ok := gc.mutation.Name(); !ok {
@@ -97,6 +252,22 @@ func (gc *GameCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Game.name": %w`, err)}
 		}
 	}
+	if _, ok := gc.mutation.SortOrder(); !ok {
+		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "Game.sort_order"`)}
+	}
+	if v, ok := gc.mutation.SortOrder(); ok {
+		if err := game.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "Game.sort_order": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.ScorePolicy(); !ok {
+		return &ValidationError{Name: "score_policy", err: errors.New(`ent: missing required field "Game.score_policy"`)}
+	}
+	if v, ok := gc.mutation.ScorePolicy(); ok {
+		if err := game.ScorePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "score_policy", err: fmt.Errorf(`ent: validator failed for field "Game.score_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -131,6 +302,18 @@ func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		_spec.SetField(game.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := gc.mutation.SortOrder(); ok {
+		_spec.SetField(game.FieldSortOrder, field.TypeEnum, value)
+		_node.SortOrder = value
+	}
+	if value, ok := gc.mutation.ScorePolicy(); ok {
+		_spec.SetField(game.FieldScorePolicy, field.TypeEnum, value)
+		_node.ScorePolicy = value
+	}
+	if value, ok := gc.mutation.SigningSecret(); ok {
+		_spec.SetField(game.FieldSigningSecret, field.TypeString, value)
+		_node.SigningSecret = value
+	}
 	if nodes := gc.mutation.ScoresIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -147,6 +330,103 @@ func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.SeasonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.ServerKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.RequestNoncesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.OwnerTable,
+			Columns: []string{game.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_owned_games = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.MaintainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -168,6 +448,7 @@ func (gcb *GameCreateBulk) Save(ctx context.Context) ([]*Game, error) {
 	for i := range gcb.builders {
 		func(i int, root context.Context) {
 			builder := gcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*GameMutation)
 				if !ok {
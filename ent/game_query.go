@@ -8,23 +8,37 @@ import (
 	"fmt"
 	"game-scores/ent/game"
 	"game-scores/ent/predicate"
+	"game-scores/ent/requestnonce"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
 	"math"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // GameQuery is the builder for querying Game entities.
 type GameQuery struct {
 	config
-	ctx        *QueryContext
-	order      []game.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Game
-	withScores *ScoreQuery
+	ctx               *QueryContext
+	order             []game.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Game
+	withScores        *ScoreQuery
+	withSubmissions   *ScoreSubmissionQuery
+	withSeasons       *SeasonQuery
+	withServerKeys    *ServerKeyQuery
+	withRequestNonces *RequestNonceQuery
+	withOwner         *UserQuery
+	withMaintainers   *UserQuery
+	withFKs           bool
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +97,138 @@ func (gq *GameQuery) QueryScores() *ScoreQuery {
 	return query
 }
 
+// QuerySubmissions chains the current query on the "submissions" edge.
+func (gq *GameQuery) QuerySubmissions() *ScoreSubmissionQuery {
+	query := (&ScoreSubmissionClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(scoresubmission.Table, scoresubmission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.SubmissionsTable, game.SubmissionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeasons chains the current query on the "seasons" edge.
+func (gq *GameQuery) QuerySeasons() *SeasonQuery {
+	query := (&SeasonClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.SeasonsTable, game.SeasonsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryServerKeys chains the current query on the "server_keys" edge.
+func (gq *GameQuery) QueryServerKeys() *ServerKeyQuery {
+	query := (&ServerKeyClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(serverkey.Table, serverkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ServerKeysTable, game.ServerKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRequestNonces chains the current query on the "request_nonces" edge.
+func (gq *GameQuery) QueryRequestNonces() *RequestNonceQuery {
+	query := (&RequestNonceClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(requestnonce.Table, requestnonce.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.RequestNoncesTable, game.RequestNoncesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (gq *GameQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, game.OwnerTable, game.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMaintainers chains the current query on the "maintainers" edge.
+func (gq *GameQuery) QueryMaintainers() *UserQuery {
+	query := (&UserClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, game.MaintainersTable, game.MaintainersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Game entity from the query.
 // Returns a *NotFoundError when no Game was found.
 func (gq *GameQuery) First(ctx context.Context) (*Game, error) {
@@ -270,15 +416,22 @@ func (gq *GameQuery) Clone() *GameQuery {
 		return nil
 	}
 	return &GameQuery{
-		config:     gq.config,
-		ctx:        gq.ctx.Clone(),
-		order:      append([]game.OrderOption{}, gq.order...),
-		inters:     append([]Interceptor{}, gq.inters...),
-		predicates: append([]predicate.Game{}, gq.predicates...),
-		withScores: gq.withScores.Clone(),
+		config:            gq.config,
+		ctx:               gq.ctx.Clone(),
+		order:             append([]game.OrderOption{}, gq.order...),
+		inters:            append([]Interceptor{}, gq.inters...),
+		predicates:        append([]predicate.Game{}, gq.predicates...),
+		withScores:        gq.withScores.Clone(),
+		withSubmissions:   gq.withSubmissions.Clone(),
+		withSeasons:       gq.withSeasons.Clone(),
+		withServerKeys:    gq.withServerKeys.Clone(),
+		withRequestNonces: gq.withRequestNonces.Clone(),
+		withOwner:         gq.withOwner.Clone(),
+		withMaintainers:   gq.withMaintainers.Clone(),
 		// clone intermediate query.
-		sql:  gq.sql.Clone(),
-		path: gq.path,
+		sql:       gq.sql.Clone(),
+		path:      gq.path,
+		modifiers: append([]func(*sql.Selector){}, gq.modifiers...),
 	}
 }
 
@@ -293,6 +446,72 @@ func (gq *GameQuery) WithScores(opts ...func(*ScoreQuery)) *GameQuery {
 	return gq
 }
 
+// WithSubmissions tells the query-builder to eager-load the nodes that are connected to
+// the "submissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithSubmissions(opts ...func(*ScoreSubmissionQuery)) *GameQuery {
+	query := (&ScoreSubmissionClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withSubmissions = query
+	return gq
+}
+
+// WithSeasons tells the query-builder to eager-load the nodes that are connected to
+// the "seasons" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithSeasons(opts ...func(*SeasonQuery)) *GameQuery {
+	query := (&SeasonClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withSeasons = query
+	return gq
+}
+
+// WithServerKeys tells the query-builder to eager-load the nodes that are connected to
+// the "server_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithServerKeys(opts ...func(*ServerKeyQuery)) *GameQuery {
+	query := (&ServerKeyClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withServerKeys = query
+	return gq
+}
+
+// WithRequestNonces tells the query-builder to eager-load the nodes that are connected to
+// the "request_nonces" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithRequestNonces(opts ...func(*RequestNonceQuery)) *GameQuery {
+	query := (&RequestNonceClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withRequestNonces = query
+	return gq
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithOwner(opts ...func(*UserQuery)) *GameQuery {
+	query := (&UserClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withOwner = query
+	return gq
+}
+
+// WithMaintainers tells the query-builder to eager-load the nodes that are connected to
+// the "maintainers" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithMaintainers(opts ...func(*UserQuery)) *GameQuery {
+	query := (&UserClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withMaintainers = query
+	return gq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,11 +589,24 @@ func (gq *GameQuery) prepareQuery(ctx context.Context) error {
 func (gq *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, error) {
 	var (
 		nodes       = []*Game{}
+		withFKs     = gq.withFKs
 		_spec       = gq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [7]bool{
 			gq.withScores != nil,
+			gq.withSubmissions != nil,
+			gq.withSeasons != nil,
+			gq.withServerKeys != nil,
+			gq.withRequestNonces != nil,
+			gq.withOwner != nil,
+			gq.withMaintainers != nil,
 		}
 	)
+	if gq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, game.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Game).scanValues(nil, columns)
 	}
@@ -384,6 +616,9 @@ func (gq *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -400,6 +635,47 @@ func (gq *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, e
 			return nil, err
 		}
 	}
+	if query := gq.withSubmissions; query != nil {
+		if err := gq.loadSubmissions(ctx, query, nodes,
+			func(n *Game) { n.Edges.Submissions = []*ScoreSubmission{} },
+			func(n *Game, e *ScoreSubmission) { n.Edges.Submissions = append(n.Edges.Submissions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withSeasons; query != nil {
+		if err := gq.loadSeasons(ctx, query, nodes,
+			func(n *Game) { n.Edges.Seasons = []*Season{} },
+			func(n *Game, e *Season) { n.Edges.Seasons = append(n.Edges.Seasons, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withServerKeys; query != nil {
+		if err := gq.loadServerKeys(ctx, query, nodes,
+			func(n *Game) { n.Edges.ServerKeys = []*ServerKey{} },
+			func(n *Game, e *ServerKey) { n.Edges.ServerKeys = append(n.Edges.ServerKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withRequestNonces; query != nil {
+		if err := gq.loadRequestNonces(ctx, query, nodes,
+			func(n *Game) { n.Edges.RequestNonces = []*RequestNonce{} },
+			func(n *Game, e *RequestNonce) { n.Edges.RequestNonces = append(n.Edges.RequestNonces, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withOwner; query != nil {
+		if err := gq.loadOwner(ctx, query, nodes, nil,
+			func(n *Game, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withMaintainers; query != nil {
+		if err := gq.loadMaintainers(ctx, query, nodes,
+			func(n *Game) { n.Edges.Maintainers = []*User{} },
+			func(n *Game, e *User) { n.Edges.Maintainers = append(n.Edges.Maintainers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,9 +710,229 @@ func (gq *GameQuery) loadScores(ctx context.Context, query *ScoreQuery, nodes []
 	}
 	return nil
 }
+func (gq *GameQuery) loadSubmissions(ctx context.Context, query *ScoreSubmissionQuery, nodes []*Game, init func(*Game), assign func(*Game, *ScoreSubmission)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ScoreSubmission(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.SubmissionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.game_submissions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "game_submissions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_submissions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GameQuery) loadSeasons(ctx context.Context, query *SeasonQuery, nodes []*Game, init func(*Game), assign func(*Game, *Season)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Season(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.SeasonsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.game_seasons
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "game_seasons" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_seasons" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GameQuery) loadServerKeys(ctx context.Context, query *ServerKeyQuery, nodes []*Game, init func(*Game), assign func(*Game, *ServerKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ServerKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.ServerKeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.game_server_keys
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "game_server_keys" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_server_keys" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GameQuery) loadRequestNonces(ctx context.Context, query *RequestNonceQuery, nodes []*Game, init func(*Game), assign func(*Game, *RequestNonce)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.RequestNonce(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.RequestNoncesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.game_request_nonces
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "game_request_nonces" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_request_nonces" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GameQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*Game, init func(*Game), assign func(*Game, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Game)
+	for i := range nodes {
+		if nodes[i].user_owned_games == nil {
+			continue
+		}
+		fk := *nodes[i].user_owned_games
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_owned_games" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gq *GameQuery) loadMaintainers(ctx context.Context, query *UserQuery, nodes []*Game, init func(*Game), assign func(*Game, *User)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Game)
+	nids := make(map[uuid.UUID]map[*Game]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(game.MaintainersTable)
+		s.Join(joinT).On(s.C(user.FieldID), joinT.C(game.MaintainersPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(game.MaintainersPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(game.MaintainersPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Game]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*User](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "maintainers" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (gq *GameQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
+	if len(gq.modifiers) > 0 {
+		_spec.Modifiers = gq.modifiers
+	}
 	_spec.Node.Columns = gq.ctx.Fields
 	if len(gq.ctx.Fields) > 0 {
 		_spec.Unique = gq.ctx.Unique != nil && *gq.ctx.Unique
@@ -499,6 +995,9 @@ func (gq *GameQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if gq.ctx.Unique != nil && *gq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range gq.modifiers {
+		m(selector)
+	}
 	for _, p := range gq.predicates {
 		p(selector)
 	}
@@ -516,6 +1015,12 @@ func (gq *GameQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gq *GameQuery) Modify(modifiers ...func(s *sql.Selector)) *GameSelect {
+	gq.modifiers = append(gq.modifiers, modifiers...)
+	return gq.Select()
+}
+
 // GameGroupBy is the group-by builder for Game entities.
 type GameGroupBy struct {
 	selector
@@ -605,3 +1110,9 @@ func (gs *GameSelect) sqlScan(ctx context.Context, root *GameQuery, v any) error
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (gs *GameSelect) Modify(modifiers ...func(s *sql.Selector)) *GameSelect {
+	gs.modifiers = append(gs.modifiers, modifiers...)
+	return gs
+}
@@ -8,18 +8,25 @@ import (
 	"fmt"
 	"game-scores/ent/game"
 	"game-scores/ent/predicate"
+	"game-scores/ent/requestnonce"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // GameUpdate is the builder for updating Game entities.
 type GameUpdate struct {
 	config
-	hooks    []Hook
-	mutation *GameMutation
+	hooks     []Hook
+	mutation  *GameMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the GameUpdate builder.
@@ -62,6 +69,54 @@ func (gu *GameUpdate) ClearDescription() *GameUpdate {
 	return gu
 }
 
+// SetSortOrder sets the "sort_order" field.
+func (gu *GameUpdate) SetSortOrder(_go game.SortOrder) *GameUpdate {
+	gu.mutation.SetSortOrder(_go)
+	return gu
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableSortOrder(_go *game.SortOrder) *GameUpdate {
+	if _go != nil {
+		gu.SetSortOrder(*_go)
+	}
+	return gu
+}
+
+// SetScorePolicy sets the "score_policy" field.
+func (gu *GameUpdate) SetScorePolicy(gp game.ScorePolicy) *GameUpdate {
+	gu.mutation.SetScorePolicy(gp)
+	return gu
+}
+
+// SetNillableScorePolicy sets the "score_policy" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableScorePolicy(gp *game.ScorePolicy) *GameUpdate {
+	if gp != nil {
+		gu.SetScorePolicy(*gp)
+	}
+	return gu
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (gu *GameUpdate) SetSigningSecret(s string) *GameUpdate {
+	gu.mutation.SetSigningSecret(s)
+	return gu
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableSigningSecret(s *string) *GameUpdate {
+	if s != nil {
+		gu.SetSigningSecret(*s)
+	}
+	return gu
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (gu *GameUpdate) ClearSigningSecret() *GameUpdate {
+	gu.mutation.ClearSigningSecret()
+	return gu
+}
+
 // AddScoreIDs adds the "scores" edge to the Score entity by IDs.
 func (gu *GameUpdate) AddScoreIDs(ids ...int) *GameUpdate {
 	gu.mutation.AddScoreIDs(ids...)
@@ -77,6 +132,100 @@ func (gu *GameUpdate) AddScores(s ...*Score) *GameUpdate {
 	return gu.AddScoreIDs(ids...)
 }
 
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (gu *GameUpdate) AddSubmissionIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddSubmissionIDs(ids...)
+	return gu
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (gu *GameUpdate) AddSubmissions(s ...*ScoreSubmission) *GameUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.AddSubmissionIDs(ids...)
+}
+
+// AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
+func (gu *GameUpdate) AddSeasonIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddSeasonIDs(ids...)
+	return gu
+}
+
+// AddSeasons adds the "seasons" edges to the Season entity.
+func (gu *GameUpdate) AddSeasons(s ...*Season) *GameUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.AddSeasonIDs(ids...)
+}
+
+// AddServerKeyIDs adds the "server_keys" edge to the ServerKey entity by IDs.
+func (gu *GameUpdate) AddServerKeyIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddServerKeyIDs(ids...)
+	return gu
+}
+
+// AddServerKeys adds the "server_keys" edges to the ServerKey entity.
+func (gu *GameUpdate) AddServerKeys(s ...*ServerKey) *GameUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.AddServerKeyIDs(ids...)
+}
+
+// AddRequestNonceIDs adds the "request_nonces" edge to the RequestNonce entity by IDs.
+func (gu *GameUpdate) AddRequestNonceIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddRequestNonceIDs(ids...)
+	return gu
+}
+
+// AddRequestNonces adds the "request_nonces" edges to the RequestNonce entity.
+func (gu *GameUpdate) AddRequestNonces(r ...*RequestNonce) *GameUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return gu.AddRequestNonceIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (gu *GameUpdate) SetOwnerID(id uuid.UUID) *GameUpdate {
+	gu.mutation.SetOwnerID(id)
+	return gu
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (gu *GameUpdate) SetNillableOwnerID(id *uuid.UUID) *GameUpdate {
+	if id != nil {
+		gu = gu.SetOwnerID(*id)
+	}
+	return gu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (gu *GameUpdate) SetOwner(u *User) *GameUpdate {
+	return gu.SetOwnerID(u.ID)
+}
+
+// AddMaintainerIDs adds the "maintainers" edge to the User entity by IDs.
+func (gu *GameUpdate) AddMaintainerIDs(ids ...uuid.UUID) *GameUpdate {
+	gu.mutation.AddMaintainerIDs(ids...)
+	return gu
+}
+
+// AddMaintainers adds the "maintainers" edges to the User entity.
+func (gu *GameUpdate) AddMaintainers(u ...*User) *GameUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gu.AddMaintainerIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (gu *GameUpdate) Mutation() *GameMutation {
 	return gu.mutation
@@ -103,6 +252,117 @@ func (gu *GameUpdate) RemoveScores(s ...*Score) *GameUpdate {
 	return gu.RemoveScoreIDs(ids...)
 }
 
+// ClearSubmissions clears all "submissions" edges to the ScoreSubmission entity.
+func (gu *GameUpdate) ClearSubmissions() *GameUpdate {
+	gu.mutation.ClearSubmissions()
+	return gu
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScoreSubmission entities by IDs.
+func (gu *GameUpdate) RemoveSubmissionIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveSubmissionIDs(ids...)
+	return gu
+}
+
+// RemoveSubmissions removes "submissions" edges to ScoreSubmission entities.
+func (gu *GameUpdate) RemoveSubmissions(s ...*ScoreSubmission) *GameUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.RemoveSubmissionIDs(ids...)
+}
+
+// ClearSeasons clears all "seasons" edges to the Season entity.
+func (gu *GameUpdate) ClearSeasons() *GameUpdate {
+	gu.mutation.ClearSeasons()
+	return gu
+}
+
+// RemoveSeasonIDs removes the "seasons" edge to Season entities by IDs.
+func (gu *GameUpdate) RemoveSeasonIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveSeasonIDs(ids...)
+	return gu
+}
+
+// RemoveSeasons removes "seasons" edges to Season entities.
+func (gu *GameUpdate) RemoveSeasons(s ...*Season) *GameUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.RemoveSeasonIDs(ids...)
+}
+
+// ClearServerKeys clears all "server_keys" edges to the ServerKey entity.
+func (gu *GameUpdate) ClearServerKeys() *GameUpdate {
+	gu.mutation.ClearServerKeys()
+	return gu
+}
+
+// RemoveServerKeyIDs removes the "server_keys" edge to ServerKey entities by IDs.
+func (gu *GameUpdate) RemoveServerKeyIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveServerKeyIDs(ids...)
+	return gu
+}
+
+// RemoveServerKeys removes "server_keys" edges to ServerKey entities.
+func (gu *GameUpdate) RemoveServerKeys(s ...*ServerKey) *GameUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return gu.RemoveServerKeyIDs(ids...)
+}
+
+// ClearRequestNonces clears all "request_nonces" edges to the RequestNonce entity.
+func (gu *GameUpdate) ClearRequestNonces() *GameUpdate {
+	gu.mutation.ClearRequestNonces()
+	return gu
+}
+
+// RemoveRequestNonceIDs removes the "request_nonces" edge to RequestNonce entities by IDs.
+func (gu *GameUpdate) RemoveRequestNonceIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveRequestNonceIDs(ids...)
+	return gu
+}
+
+// RemoveRequestNonces removes "request_nonces" edges to RequestNonce entities.
+func (gu *GameUpdate) RemoveRequestNonces(r ...*RequestNonce) *GameUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return gu.RemoveRequestNonceIDs(ids...)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (gu *GameUpdate) ClearOwner() *GameUpdate {
+	gu.mutation.ClearOwner()
+	return gu
+}
+
+// ClearMaintainers clears all "maintainers" edges to the User entity.
+func (gu *GameUpdate) ClearMaintainers() *GameUpdate {
+	gu.mutation.ClearMaintainers()
+	return gu
+}
+
+// RemoveMaintainerIDs removes the "maintainers" edge to User entities by IDs.
+func (gu *GameUpdate) RemoveMaintainerIDs(ids ...uuid.UUID) *GameUpdate {
+	gu.mutation.RemoveMaintainerIDs(ids...)
+	return gu
+}
+
+// RemoveMaintainers removes "maintainers" edges to User entities.
+func (gu *GameUpdate) RemoveMaintainers(u ...*User) *GameUpdate {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return gu.RemoveMaintainerIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GameUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
@@ -137,9 +397,25 @@ func (gu *GameUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Game.name": %w`, err)}
 		}
 	}
+	if v, ok := gu.mutation.SortOrder(); ok {
+		if err := game.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "Game.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.ScorePolicy(); ok {
+		if err := game.ScorePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "score_policy", err: fmt.Errorf(`ent: validator failed for field "Game.score_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (gu *GameUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GameUpdate {
+	gu.modifiers = append(gu.modifiers, modifiers...)
+	return gu
+}
+
 func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := gu.check(); err != nil {
 		return n, err
@@ -161,6 +437,18 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if gu.mutation.DescriptionCleared() {
 		_spec.ClearField(game.FieldDescription, field.TypeString)
 	}
+	if value, ok := gu.mutation.SortOrder(); ok {
+		_spec.SetField(game.FieldSortOrder, field.TypeEnum, value)
+	}
+	if value, ok := gu.mutation.ScorePolicy(); ok {
+		_spec.SetField(game.FieldScorePolicy, field.TypeEnum, value)
+	}
+	if value, ok := gu.mutation.SigningSecret(); ok {
+		_spec.SetField(game.FieldSigningSecret, field.TypeString, value)
+	}
+	if gu.mutation.SigningSecretCleared() {
+		_spec.ClearField(game.FieldSigningSecret, field.TypeString)
+	}
 	if gu.mutation.ScoresCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -206,6 +494,261 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !gu.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.SeasonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedSeasonsIDs(); len(nodes) > 0 && !gu.mutation.SeasonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.SeasonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.ServerKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedServerKeysIDs(); len(nodes) > 0 && !gu.mutation.ServerKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ServerKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.RequestNoncesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedRequestNoncesIDs(); len(nodes) > 0 && !gu.mutation.RequestNoncesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RequestNoncesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.OwnerTable,
+			Columns: []string{game.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.OwnerTable,
+			Columns: []string{game.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.MaintainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedMaintainersIDs(); len(nodes) > 0 && !gu.mutation.MaintainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MaintainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(gu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{game.Label}
@@ -214,91 +757,345 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	gu.mutation.done = true
-	return n, nil
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GameUpdateOne is the builder for updating a single Game entity.
+type GameUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *GameMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (guo *GameUpdateOne) SetName(s string) *GameUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableName(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetDescription sets the "description" field.
+func (guo *GameUpdateOne) SetDescription(s string) *GameUpdateOne {
+	guo.mutation.SetDescription(s)
+	return guo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableDescription(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetDescription(*s)
+	}
+	return guo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (guo *GameUpdateOne) ClearDescription() *GameUpdateOne {
+	guo.mutation.ClearDescription()
+	return guo
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (guo *GameUpdateOne) SetSortOrder(_go game.SortOrder) *GameUpdateOne {
+	guo.mutation.SetSortOrder(_go)
+	return guo
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableSortOrder(_go *game.SortOrder) *GameUpdateOne {
+	if _go != nil {
+		guo.SetSortOrder(*_go)
+	}
+	return guo
+}
+
+// SetScorePolicy sets the "score_policy" field.
+func (guo *GameUpdateOne) SetScorePolicy(gp game.ScorePolicy) *GameUpdateOne {
+	guo.mutation.SetScorePolicy(gp)
+	return guo
+}
+
+// SetNillableScorePolicy sets the "score_policy" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableScorePolicy(gp *game.ScorePolicy) *GameUpdateOne {
+	if gp != nil {
+		guo.SetScorePolicy(*gp)
+	}
+	return guo
+}
+
+// SetSigningSecret sets the "signing_secret" field.
+func (guo *GameUpdateOne) SetSigningSecret(s string) *GameUpdateOne {
+	guo.mutation.SetSigningSecret(s)
+	return guo
+}
+
+// SetNillableSigningSecret sets the "signing_secret" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableSigningSecret(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetSigningSecret(*s)
+	}
+	return guo
+}
+
+// ClearSigningSecret clears the value of the "signing_secret" field.
+func (guo *GameUpdateOne) ClearSigningSecret() *GameUpdateOne {
+	guo.mutation.ClearSigningSecret()
+	return guo
+}
+
+// AddScoreIDs adds the "scores" edge to the Score entity by IDs.
+func (guo *GameUpdateOne) AddScoreIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddScoreIDs(ids...)
+	return guo
+}
+
+// AddScores adds the "scores" edges to the Score entity.
+func (guo *GameUpdateOne) AddScores(s ...*Score) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.AddScoreIDs(ids...)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (guo *GameUpdateOne) AddSubmissionIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddSubmissionIDs(ids...)
+	return guo
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (guo *GameUpdateOne) AddSubmissions(s ...*ScoreSubmission) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.AddSubmissionIDs(ids...)
+}
+
+// AddSeasonIDs adds the "seasons" edge to the Season entity by IDs.
+func (guo *GameUpdateOne) AddSeasonIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddSeasonIDs(ids...)
+	return guo
+}
+
+// AddSeasons adds the "seasons" edges to the Season entity.
+func (guo *GameUpdateOne) AddSeasons(s ...*Season) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.AddSeasonIDs(ids...)
+}
+
+// AddServerKeyIDs adds the "server_keys" edge to the ServerKey entity by IDs.
+func (guo *GameUpdateOne) AddServerKeyIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddServerKeyIDs(ids...)
+	return guo
+}
+
+// AddServerKeys adds the "server_keys" edges to the ServerKey entity.
+func (guo *GameUpdateOne) AddServerKeys(s ...*ServerKey) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.AddServerKeyIDs(ids...)
+}
+
+// AddRequestNonceIDs adds the "request_nonces" edge to the RequestNonce entity by IDs.
+func (guo *GameUpdateOne) AddRequestNonceIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddRequestNonceIDs(ids...)
+	return guo
+}
+
+// AddRequestNonces adds the "request_nonces" edges to the RequestNonce entity.
+func (guo *GameUpdateOne) AddRequestNonces(r ...*RequestNonce) *GameUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return guo.AddRequestNonceIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (guo *GameUpdateOne) SetOwnerID(id uuid.UUID) *GameUpdateOne {
+	guo.mutation.SetOwnerID(id)
+	return guo
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableOwnerID(id *uuid.UUID) *GameUpdateOne {
+	if id != nil {
+		guo = guo.SetOwnerID(*id)
+	}
+	return guo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (guo *GameUpdateOne) SetOwner(u *User) *GameUpdateOne {
+	return guo.SetOwnerID(u.ID)
+}
+
+// AddMaintainerIDs adds the "maintainers" edge to the User entity by IDs.
+func (guo *GameUpdateOne) AddMaintainerIDs(ids ...uuid.UUID) *GameUpdateOne {
+	guo.mutation.AddMaintainerIDs(ids...)
+	return guo
+}
+
+// AddMaintainers adds the "maintainers" edges to the User entity.
+func (guo *GameUpdateOne) AddMaintainers(u ...*User) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return guo.AddMaintainerIDs(ids...)
+}
+
+// Mutation returns the GameMutation object of the builder.
+func (guo *GameUpdateOne) Mutation() *GameMutation {
+	return guo.mutation
+}
+
+// ClearScores clears all "scores" edges to the Score entity.
+func (guo *GameUpdateOne) ClearScores() *GameUpdateOne {
+	guo.mutation.ClearScores()
+	return guo
+}
+
+// RemoveScoreIDs removes the "scores" edge to Score entities by IDs.
+func (guo *GameUpdateOne) RemoveScoreIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveScoreIDs(ids...)
+	return guo
+}
+
+// RemoveScores removes "scores" edges to Score entities.
+func (guo *GameUpdateOne) RemoveScores(s ...*Score) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return guo.RemoveScoreIDs(ids...)
 }
 
-// GameUpdateOne is the builder for updating a single Game entity.
-type GameUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *GameMutation
+// ClearSubmissions clears all "submissions" edges to the ScoreSubmission entity.
+func (guo *GameUpdateOne) ClearSubmissions() *GameUpdateOne {
+	guo.mutation.ClearSubmissions()
+	return guo
 }
 
-// SetName sets the "name" field.
-func (guo *GameUpdateOne) SetName(s string) *GameUpdateOne {
-	guo.mutation.SetName(s)
+// RemoveSubmissionIDs removes the "submissions" edge to ScoreSubmission entities by IDs.
+func (guo *GameUpdateOne) RemoveSubmissionIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveSubmissionIDs(ids...)
 	return guo
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (guo *GameUpdateOne) SetNillableName(s *string) *GameUpdateOne {
-	if s != nil {
-		guo.SetName(*s)
+// RemoveSubmissions removes "submissions" edges to ScoreSubmission entities.
+func (guo *GameUpdateOne) RemoveSubmissions(s ...*ScoreSubmission) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
+	return guo.RemoveSubmissionIDs(ids...)
+}
+
+// ClearSeasons clears all "seasons" edges to the Season entity.
+func (guo *GameUpdateOne) ClearSeasons() *GameUpdateOne {
+	guo.mutation.ClearSeasons()
 	return guo
 }
 
-// SetDescription sets the "description" field.
-func (guo *GameUpdateOne) SetDescription(s string) *GameUpdateOne {
-	guo.mutation.SetDescription(s)
+// RemoveSeasonIDs removes the "seasons" edge to Season entities by IDs.
+func (guo *GameUpdateOne) RemoveSeasonIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveSeasonIDs(ids...)
 	return guo
 }
 
-// SetNillableDescription sets the "description" field if the given value is not nil.
-func (guo *GameUpdateOne) SetNillableDescription(s *string) *GameUpdateOne {
-	if s != nil {
-		guo.SetDescription(*s)
+// RemoveSeasons removes "seasons" edges to Season entities.
+func (guo *GameUpdateOne) RemoveSeasons(s ...*Season) *GameUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
-	return guo
+	return guo.RemoveSeasonIDs(ids...)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (guo *GameUpdateOne) ClearDescription() *GameUpdateOne {
-	guo.mutation.ClearDescription()
+// ClearServerKeys clears all "server_keys" edges to the ServerKey entity.
+func (guo *GameUpdateOne) ClearServerKeys() *GameUpdateOne {
+	guo.mutation.ClearServerKeys()
 	return guo
 }
 
-// AddScoreIDs adds the "scores" edge to the Score entity by IDs.
-func (guo *GameUpdateOne) AddScoreIDs(ids ...int) *GameUpdateOne {
-	guo.mutation.AddScoreIDs(ids...)
+// RemoveServerKeyIDs removes the "server_keys" edge to ServerKey entities by IDs.
+func (guo *GameUpdateOne) RemoveServerKeyIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveServerKeyIDs(ids...)
 	return guo
 }
 
-// AddScores adds the "scores" edges to the Score entity.
-func (guo *GameUpdateOne) AddScores(s ...*Score) *GameUpdateOne {
+// RemoveServerKeys removes "server_keys" edges to ServerKey entities.
+func (guo *GameUpdateOne) RemoveServerKeys(s ...*ServerKey) *GameUpdateOne {
 	ids := make([]int, len(s))
 	for i := range s {
 		ids[i] = s[i].ID
 	}
-	return guo.AddScoreIDs(ids...)
+	return guo.RemoveServerKeyIDs(ids...)
 }
 
-// Mutation returns the GameMutation object of the builder.
-func (guo *GameUpdateOne) Mutation() *GameMutation {
-	return guo.mutation
+// ClearRequestNonces clears all "request_nonces" edges to the RequestNonce entity.
+func (guo *GameUpdateOne) ClearRequestNonces() *GameUpdateOne {
+	guo.mutation.ClearRequestNonces()
+	return guo
 }
 
-// ClearScores clears all "scores" edges to the Score entity.
-func (guo *GameUpdateOne) ClearScores() *GameUpdateOne {
-	guo.mutation.ClearScores()
+// RemoveRequestNonceIDs removes the "request_nonces" edge to RequestNonce entities by IDs.
+func (guo *GameUpdateOne) RemoveRequestNonceIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveRequestNonceIDs(ids...)
 	return guo
 }
 
-// RemoveScoreIDs removes the "scores" edge to Score entities by IDs.
-func (guo *GameUpdateOne) RemoveScoreIDs(ids ...int) *GameUpdateOne {
-	guo.mutation.RemoveScoreIDs(ids...)
+// RemoveRequestNonces removes "request_nonces" edges to RequestNonce entities.
+func (guo *GameUpdateOne) RemoveRequestNonces(r ...*RequestNonce) *GameUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return guo.RemoveRequestNonceIDs(ids...)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (guo *GameUpdateOne) ClearOwner() *GameUpdateOne {
+	guo.mutation.ClearOwner()
 	return guo
 }
 
-// RemoveScores removes "scores" edges to Score entities.
-func (guo *GameUpdateOne) RemoveScores(s ...*Score) *GameUpdateOne {
-	ids := make([]int, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// ClearMaintainers clears all "maintainers" edges to the User entity.
+func (guo *GameUpdateOne) ClearMaintainers() *GameUpdateOne {
+	guo.mutation.ClearMaintainers()
+	return guo
+}
+
+// RemoveMaintainerIDs removes the "maintainers" edge to User entities by IDs.
+func (guo *GameUpdateOne) RemoveMaintainerIDs(ids ...uuid.UUID) *GameUpdateOne {
+	guo.mutation.RemoveMaintainerIDs(ids...)
+	return guo
+}
+
+// RemoveMaintainers removes "maintainers" edges to User entities.
+func (guo *GameUpdateOne) RemoveMaintainers(u ...*User) *GameUpdateOne {
+	ids := make([]uuid.UUID, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
 	}
-	return guo.RemoveScoreIDs(ids...)
+	return guo.RemoveMaintainerIDs(ids...)
 }
 
 // Where appends a list predicates to the GameUpdate builder.
@@ -348,9 +1145,25 @@ func (guo *GameUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Game.name": %w`, err)}
 		}
 	}
+	if v, ok := guo.mutation.SortOrder(); ok {
+		if err := game.SortOrderValidator(v); err != nil {
+			return &ValidationError{Name: "sort_order", err: fmt.Errorf(`ent: validator failed for field "Game.sort_order": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.ScorePolicy(); ok {
+		if err := game.ScorePolicyValidator(v); err != nil {
+			return &ValidationError{Name: "score_policy", err: fmt.Errorf(`ent: validator failed for field "Game.score_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (guo *GameUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *GameUpdateOne {
+	guo.modifiers = append(guo.modifiers, modifiers...)
+	return guo
+}
+
 func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error) {
 	if err := guo.check(); err != nil {
 		return _node, err
@@ -389,6 +1202,18 @@ func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error)
 	if guo.mutation.DescriptionCleared() {
 		_spec.ClearField(game.FieldDescription, field.TypeString)
 	}
+	if value, ok := guo.mutation.SortOrder(); ok {
+		_spec.SetField(game.FieldSortOrder, field.TypeEnum, value)
+	}
+	if value, ok := guo.mutation.ScorePolicy(); ok {
+		_spec.SetField(game.FieldScorePolicy, field.TypeEnum, value)
+	}
+	if value, ok := guo.mutation.SigningSecret(); ok {
+		_spec.SetField(game.FieldSigningSecret, field.TypeString, value)
+	}
+	if guo.mutation.SigningSecretCleared() {
+		_spec.ClearField(game.FieldSigningSecret, field.TypeString)
+	}
 	if guo.mutation.ScoresCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -434,6 +1259,261 @@ func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !guo.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SubmissionsTable,
+			Columns: []string{game.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.SeasonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedSeasonsIDs(); len(nodes) > 0 && !guo.mutation.SeasonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.SeasonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.SeasonsTable,
+			Columns: []string{game.SeasonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.ServerKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedServerKeysIDs(); len(nodes) > 0 && !guo.mutation.ServerKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ServerKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ServerKeysTable,
+			Columns: []string{game.ServerKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.RequestNoncesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedRequestNoncesIDs(); len(nodes) > 0 && !guo.mutation.RequestNoncesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RequestNoncesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.RequestNoncesTable,
+			Columns: []string{game.RequestNoncesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.OwnerTable,
+			Columns: []string{game.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   game.OwnerTable,
+			Columns: []string{game.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.MaintainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedMaintainersIDs(); len(nodes) > 0 && !guo.mutation.MaintainersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MaintainersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   game.MaintainersTable,
+			Columns: game.MaintainersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(guo.modifiers...)
 	_node = &Game{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
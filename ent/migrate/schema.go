@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -13,19 +14,209 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "sort_order", Type: field.TypeEnum, Enums: []string{"descending", "ascending"}, Default: "descending"},
+		{Name: "score_policy", Type: field.TypeEnum, Enums: []string{"best", "latest", "cumulative"}, Default: "best"},
+		{Name: "signing_secret", Type: field.TypeString, Nullable: true},
+		{Name: "user_owned_games", Type: field.TypeUUID, Nullable: true},
 	}
 	// GamesTable holds the schema information for the "games" table.
 	GamesTable = &schema.Table{
 		Name:       "games",
 		Columns:    GamesColumns,
 		PrimaryKey: []*schema.Column{GamesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "games_users_owned_games",
+				Columns:    []*schema.Column{GamesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_login_at", Type: field.TypeTime},
+		{Name: "user_identities", Type: field.TypeUUID},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_subject",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[1], IdentitiesColumns[2]},
+			},
+		},
+	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "username", Type: field.TypeString},
+		{Name: "client_ip", Type: field.TypeString},
+		{Name: "attempted_at", Type: field.TypeTime},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginattempt_username_attempted_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[1], LoginAttemptsColumns[3]},
+			},
+			{
+				Name:    "loginattempt_client_ip_attempted_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[2], LoginAttemptsColumns[3]},
+			},
+		},
+	}
+	// PasswordResetTokensColumns holds the columns for the "password_reset_tokens" table.
+	PasswordResetTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_password_reset_tokens", Type: field.TypeUUID},
+	}
+	// PasswordResetTokensTable holds the schema information for the "password_reset_tokens" table.
+	PasswordResetTokensTable = &schema.Table{
+		Name:       "password_reset_tokens",
+		Columns:    PasswordResetTokensColumns,
+		PrimaryKey: []*schema.Column{PasswordResetTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_reset_tokens_users_password_reset_tokens",
+				Columns:    []*schema.Column{PasswordResetTokensColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_recovery_codes", Type: field.TypeUUID},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "family", Type: field.TypeUUID},
+		{Name: "device", Type: field.TypeString, Nullable: true},
+		{Name: "two_factor", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_refresh_tokens", Type: field.TypeUUID},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[2]},
+			},
+		},
+	}
+	// RequestNoncesColumns holds the columns for the "request_nonces" table.
+	RequestNoncesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "nonce", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "game_request_nonces", Type: field.TypeInt},
+	}
+	// RequestNoncesTable holds the schema information for the "request_nonces" table.
+	RequestNoncesTable = &schema.Table{
+		Name:       "request_nonces",
+		Columns:    RequestNoncesColumns,
+		PrimaryKey: []*schema.Column{RequestNoncesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "request_nonces_games_request_nonces",
+				Columns:    []*schema.Column{RequestNoncesColumns[3]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "requestnonce_nonce_game_request_nonces",
+				Unique:  true,
+				Columns: []*schema.Column{RequestNoncesColumns[1], RequestNoncesColumns[3]},
+			},
+		},
+	}
+	// RevokedTokensColumns holds the columns for the "revoked_tokens" table.
+	RevokedTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "jti", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+	}
+	// RevokedTokensTable holds the schema information for the "revoked_tokens" table.
+	RevokedTokensTable = &schema.Table{
+		Name:       "revoked_tokens",
+		Columns:    RevokedTokensColumns,
+		PrimaryKey: []*schema.Column{RevokedTokensColumns[0]},
 	}
 	// ScoresColumns holds the columns for the "scores" table.
 	ScoresColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "value", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "achieved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "game_scores", Type: field.TypeInt},
+		{Name: "season_scores", Type: field.TypeInt, Nullable: true},
 		{Name: "user_scores", Type: field.TypeUUID},
 	}
 	// ScoresTable holds the schema information for the "scores" table.
@@ -36,25 +227,160 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "scores_games_scores",
-				Columns:    []*schema.Column{ScoresColumns[3]},
+				Columns:    []*schema.Column{ScoresColumns[4]},
 				RefColumns: []*schema.Column{GamesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "scores_seasons_scores",
+				Columns:    []*schema.Column{ScoresColumns[5]},
+				RefColumns: []*schema.Column{SeasonsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "scores_users_scores",
-				Columns:    []*schema.Column{ScoresColumns[4]},
+				Columns:    []*schema.Column{ScoresColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "score_user_scores_game_scores_season_scores",
+				Unique:  true,
+				Columns: []*schema.Column{ScoresColumns[6], ScoresColumns[4], ScoresColumns[5]},
+			},
+			{
+				Name:    "score_user_scores_game_scores",
+				Unique:  true,
+				Columns: []*schema.Column{ScoresColumns[6], ScoresColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "season_scores IS NULL",
+				},
+			},
+		},
+	}
+	// ScoreSubmissionsColumns holds the columns for the "score_submissions" table.
+	ScoreSubmissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "value", Type: field.TypeInt64},
+		{Name: "accepted", Type: field.TypeBool, Default: false},
+		{Name: "submitted_at", Type: field.TypeTime},
+		{Name: "client_ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "game_submissions", Type: field.TypeInt},
+		{Name: "score_submissions", Type: field.TypeInt, Nullable: true},
+		{Name: "server_key_submissions", Type: field.TypeInt, Nullable: true},
+		{Name: "user_submissions", Type: field.TypeUUID},
+	}
+	// ScoreSubmissionsTable holds the schema information for the "score_submissions" table.
+	ScoreSubmissionsTable = &schema.Table{
+		Name:       "score_submissions",
+		Columns:    ScoreSubmissionsColumns,
+		PrimaryKey: []*schema.Column{ScoreSubmissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "score_submissions_games_submissions",
+				Columns:    []*schema.Column{ScoreSubmissionsColumns[7]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "score_submissions_scores_submissions",
+				Columns:    []*schema.Column{ScoreSubmissionsColumns[8]},
+				RefColumns: []*schema.Column{ScoresColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "score_submissions_server_keys_submissions",
+				Columns:    []*schema.Column{ScoreSubmissionsColumns[9]},
+				RefColumns: []*schema.Column{ServerKeysColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "score_submissions_users_submissions",
+				Columns:    []*schema.Column{ScoreSubmissionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scoresubmission_user_submissions_game_submissions",
+				Unique:  false,
+				Columns: []*schema.Column{ScoreSubmissionsColumns[10], ScoreSubmissionsColumns[7]},
+			},
+			{
+				Name:    "scoresubmission_submitted_at_score_submissions",
+				Unique:  false,
+				Columns: []*schema.Column{ScoreSubmissionsColumns[3], ScoreSubmissionsColumns[8]},
+			},
+		},
+	}
+	// SeasonsColumns holds the columns for the "seasons" table.
+	SeasonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "starts_at", Type: field.TypeTime},
+		{Name: "ends_at", Type: field.TypeTime},
+		{Name: "game_seasons", Type: field.TypeInt},
+	}
+	// SeasonsTable holds the schema information for the "seasons" table.
+	SeasonsTable = &schema.Table{
+		Name:       "seasons",
+		Columns:    SeasonsColumns,
+		PrimaryKey: []*schema.Column{SeasonsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "seasons_games_seasons",
+				Columns:    []*schema.Column{SeasonsColumns[4]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ServerKeysColumns holds the columns for the "server_keys" table.
+	ServerKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "key_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "game_server_keys", Type: field.TypeInt},
+	}
+	// ServerKeysTable holds the schema information for the "server_keys" table.
+	ServerKeysTable = &schema.Table{
+		Name:       "server_keys",
+		Columns:    ServerKeysColumns,
+		PrimaryKey: []*schema.Column{ServerKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "server_keys_games_server_keys",
+				Columns:    []*schema.Column{ServerKeysColumns[8]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "username", Type: field.TypeString, Unique: true},
+		{Name: "username_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "username_skeleton", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
 		{Name: "password_hash", Type: field.TypeString},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"player", "admin"}, Default: "player"},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"player", "moderator", "game_owner", "admin"}, Default: "player"},
+		{Name: "banned", Type: field.TypeBool, Default: false},
+		{Name: "tokens_valid_after", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled", Type: field.TypeBool, Default: false},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -62,15 +388,66 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// UserMaintainedGamesColumns holds the columns for the "user_maintained_games" table.
+	UserMaintainedGamesColumns = []*schema.Column{
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "game_id", Type: field.TypeInt},
+	}
+	// UserMaintainedGamesTable holds the schema information for the "user_maintained_games" table.
+	UserMaintainedGamesTable = &schema.Table{
+		Name:       "user_maintained_games",
+		Columns:    UserMaintainedGamesColumns,
+		PrimaryKey: []*schema.Column{UserMaintainedGamesColumns[0], UserMaintainedGamesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "user_maintained_games_user_id",
+				Columns:    []*schema.Column{UserMaintainedGamesColumns[0]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "user_maintained_games_game_id",
+				Columns:    []*schema.Column{UserMaintainedGamesColumns[1]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		GamesTable,
+		IdentitiesTable,
+		LoginAttemptsTable,
+		PasswordResetTokensTable,
+		RecoveryCodesTable,
+		RefreshTokensTable,
+		RequestNoncesTable,
+		RevokedTokensTable,
 		ScoresTable,
+		ScoreSubmissionsTable,
+		SeasonsTable,
+		ServerKeysTable,
 		UsersTable,
+		UserMaintainedGamesTable,
 	}
 )
 
 func init() {
+	GamesTable.ForeignKeys[0].RefTable = UsersTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
+	PasswordResetTokensTable.ForeignKeys[0].RefTable = UsersTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
+	RequestNoncesTable.ForeignKeys[0].RefTable = GamesTable
 	ScoresTable.ForeignKeys[0].RefTable = GamesTable
-	ScoresTable.ForeignKeys[1].RefTable = UsersTable
+	ScoresTable.ForeignKeys[1].RefTable = SeasonsTable
+	ScoresTable.ForeignKeys[2].RefTable = UsersTable
+	ScoreSubmissionsTable.ForeignKeys[0].RefTable = GamesTable
+	ScoreSubmissionsTable.ForeignKeys[1].RefTable = ScoresTable
+	ScoreSubmissionsTable.ForeignKeys[2].RefTable = ServerKeysTable
+	ScoreSubmissionsTable.ForeignKeys[3].RefTable = UsersTable
+	SeasonsTable.ForeignKeys[0].RefTable = GamesTable
+	ServerKeysTable.ForeignKeys[0].RefTable = GamesTable
+	UserMaintainedGamesTable.ForeignKeys[0].RefTable = UsersTable
+	UserMaintainedGamesTable.ForeignKeys[1].RefTable = GamesTable
 }
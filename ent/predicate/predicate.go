@@ -9,8 +9,38 @@ import (
 // Game is the predicate function for game builders.
 type Game func(*sql.Selector)
 
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
+// LoginAttempt is the predicate function for loginattempt builders.
+type LoginAttempt func(*sql.Selector)
+
+// PasswordResetToken is the predicate function for passwordresettoken builders.
+type PasswordResetToken func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// RequestNonce is the predicate function for requestnonce builders.
+type RequestNonce func(*sql.Selector)
+
+// RevokedToken is the predicate function for revokedtoken builders.
+type RevokedToken func(*sql.Selector)
+
 // Score is the predicate function for score builders.
 type Score func(*sql.Selector)
 
+// ScoreSubmission is the predicate function for scoresubmission builders.
+type ScoreSubmission func(*sql.Selector)
+
+// Season is the predicate function for season builders.
+type Season func(*sql.Selector)
+
+// ServerKey is the predicate function for serverkey builders.
+type ServerKey func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/requestnonce"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RequestNonce is the model entity for the RequestNonce schema.
+type RequestNonce struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RequestNonceQuery when eager-loading is set.
+	Edges               RequestNonceEdges `json:"edges"`
+	game_request_nonces *int
+	selectValues        sql.SelectValues
+}
+
+// RequestNonceEdges holds the relations/edges for other nodes in the graph.
+type RequestNonceEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RequestNonceEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RequestNonce) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case requestnonce.FieldID:
+			values[i] = new(sql.NullInt64)
+		case requestnonce.FieldNonce:
+			values[i] = new(sql.NullString)
+		case requestnonce.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case requestnonce.ForeignKeys[0]: // game_request_nonces
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RequestNonce fields.
+func (rn *RequestNonce) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case requestnonce.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rn.ID = int(value.Int64)
+		case requestnonce.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				rn.Nonce = value.String
+			}
+		case requestnonce.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rn.ExpiresAt = value.Time
+			}
+		case requestnonce.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field game_request_nonces", value)
+			} else if value.Valid {
+				rn.game_request_nonces = new(int)
+				*rn.game_request_nonces = int(value.Int64)
+			}
+		default:
+			rn.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RequestNonce.
+// This includes values selected through modifiers, order, etc.
+func (rn *RequestNonce) Value(name string) (ent.Value, error) {
+	return rn.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the RequestNonce entity.
+func (rn *RequestNonce) QueryGame() *GameQuery {
+	return NewRequestNonceClient(rn.config).QueryGame(rn)
+}
+
+// Update returns a builder for updating this RequestNonce.
+// Note that you need to call RequestNonce.Unwrap() before calling this method if this RequestNonce
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rn *RequestNonce) Update() *RequestNonceUpdateOne {
+	return NewRequestNonceClient(rn.config).UpdateOne(rn)
+}
+
+// Unwrap unwraps the RequestNonce entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rn *RequestNonce) Unwrap() *RequestNonce {
+	_tx, ok := rn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RequestNonce is not a transactional entity")
+	}
+	rn.config.driver = _tx.drv
+	return rn
+}
+
+// String implements the fmt.Stringer.
+func (rn *RequestNonce) String() string {
+	var builder strings.Builder
+	builder.WriteString("RequestNonce(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rn.ID))
+	builder.WriteString("nonce=")
+	builder.WriteString(rn.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rn.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RequestNonces is a parsable slice of RequestNonce.
+type RequestNonces []*RequestNonce
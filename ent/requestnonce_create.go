@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/requestnonce"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RequestNonceCreate is the builder for creating a RequestNonce entity.
+type RequestNonceCreate struct {
+	config
+	mutation *RequestNonceMutation
+	hooks    []Hook
+}
+
+// SetNonce sets the "nonce" field.
+func (rnc *RequestNonceCreate) SetNonce(s string) *RequestNonceCreate {
+	rnc.mutation.SetNonce(s)
+	return rnc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rnc *RequestNonceCreate) SetExpiresAt(t time.Time) *RequestNonceCreate {
+	rnc.mutation.SetExpiresAt(t)
+	return rnc
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (rnc *RequestNonceCreate) SetGameID(id int) *RequestNonceCreate {
+	rnc.mutation.SetGameID(id)
+	return rnc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (rnc *RequestNonceCreate) SetGame(g *Game) *RequestNonceCreate {
+	return rnc.SetGameID(g.ID)
+}
+
+// Mutation returns the RequestNonceMutation object of the builder.
+func (rnc *RequestNonceCreate) Mutation() *RequestNonceMutation {
+	return rnc.mutation
+}
+
+// Save creates the RequestNonce in the database.
+func (rnc *RequestNonceCreate) Save(ctx context.Context) (*RequestNonce, error) {
+	return withHooks(ctx, rnc.sqlSave, rnc.mutation, rnc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rnc *RequestNonceCreate) SaveX(ctx context.Context) *RequestNonce {
+	v, err := rnc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rnc *RequestNonceCreate) Exec(ctx context.Context) error {
+	_, err := rnc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnc *RequestNonceCreate) ExecX(ctx context.Context) {
+	if err := rnc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rnc *RequestNonceCreate) check() error {
+	if _, ok := rnc.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "RequestNonce.nonce"`)}
+	}
+	if v, ok := rnc.mutation.Nonce(); ok {
+		if err := requestnonce.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "RequestNonce.nonce": %w`, err)}
+		}
+	}
+	if _, ok := rnc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "RequestNonce.expires_at"`)}
+	}
+	if len(rnc.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "RequestNonce.game"`)}
+	}
+	return nil
+}
+
+func (rnc *RequestNonceCreate) sqlSave(ctx context.Context) (*RequestNonce, error) {
+	if err := rnc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rnc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rnc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rnc.mutation.id = &_node.ID
+	rnc.mutation.done = true
+	return _node, nil
+}
+
+func (rnc *RequestNonceCreate) createSpec() (*RequestNonce, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RequestNonce{config: rnc.config}
+		_spec = sqlgraph.NewCreateSpec(requestnonce.Table, sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt))
+	)
+	if value, ok := rnc.mutation.Nonce(); ok {
+		_spec.SetField(requestnonce.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := rnc.mutation.ExpiresAt(); ok {
+		_spec.SetField(requestnonce.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := rnc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   requestnonce.GameTable,
+			Columns: []string{requestnonce.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_request_nonces = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RequestNonceCreateBulk is the builder for creating many RequestNonce entities in bulk.
+type RequestNonceCreateBulk struct {
+	config
+	err      error
+	builders []*RequestNonceCreate
+}
+
+// Save creates the RequestNonce entities in the database.
+func (rncb *RequestNonceCreateBulk) Save(ctx context.Context) ([]*RequestNonce, error) {
+	if rncb.err != nil {
+		return nil, rncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rncb.builders))
+	nodes := make([]*RequestNonce, len(rncb.builders))
+	mutators := make([]Mutator, len(rncb.builders))
+	for i := range rncb.builders {
+		func(i int, root context.Context) {
+			builder := rncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RequestNonceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rncb *RequestNonceCreateBulk) SaveX(ctx context.Context) []*RequestNonce {
+	v, err := rncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rncb *RequestNonceCreateBulk) Exec(ctx context.Context) error {
+	_, err := rncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rncb *RequestNonceCreateBulk) ExecX(ctx context.Context) {
+	if err := rncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
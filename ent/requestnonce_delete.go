@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"game-scores/ent/predicate"
+	"game-scores/ent/requestnonce"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RequestNonceDelete is the builder for deleting a RequestNonce entity.
+type RequestNonceDelete struct {
+	config
+	hooks    []Hook
+	mutation *RequestNonceMutation
+}
+
+// Where appends a list predicates to the RequestNonceDelete builder.
+func (rnd *RequestNonceDelete) Where(ps ...predicate.RequestNonce) *RequestNonceDelete {
+	rnd.mutation.Where(ps...)
+	return rnd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rnd *RequestNonceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rnd.sqlExec, rnd.mutation, rnd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnd *RequestNonceDelete) ExecX(ctx context.Context) int {
+	n, err := rnd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rnd *RequestNonceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(requestnonce.Table, sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt))
+	if ps := rnd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rnd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rnd.mutation.done = true
+	return affected, err
+}
+
+// RequestNonceDeleteOne is the builder for deleting a single RequestNonce entity.
+type RequestNonceDeleteOne struct {
+	rnd *RequestNonceDelete
+}
+
+// Where appends a list predicates to the RequestNonceDelete builder.
+func (rndo *RequestNonceDeleteOne) Where(ps ...predicate.RequestNonce) *RequestNonceDeleteOne {
+	rndo.rnd.mutation.Where(ps...)
+	return rndo
+}
+
+// Exec executes the deletion query.
+func (rndo *RequestNonceDeleteOne) Exec(ctx context.Context) error {
+	n, err := rndo.rnd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{requestnonce.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rndo *RequestNonceDeleteOne) ExecX(ctx context.Context) {
+	if err := rndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
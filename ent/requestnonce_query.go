@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/requestnonce"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RequestNonceQuery is the builder for querying RequestNonce entities.
+type RequestNonceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []requestnonce.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RequestNonce
+	withGame   *GameQuery
+	withFKs    bool
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RequestNonceQuery builder.
+func (rnq *RequestNonceQuery) Where(ps ...predicate.RequestNonce) *RequestNonceQuery {
+	rnq.predicates = append(rnq.predicates, ps...)
+	return rnq
+}
+
+// Limit the number of records to be returned by this query.
+func (rnq *RequestNonceQuery) Limit(limit int) *RequestNonceQuery {
+	rnq.ctx.Limit = &limit
+	return rnq
+}
+
+// Offset to start from.
+func (rnq *RequestNonceQuery) Offset(offset int) *RequestNonceQuery {
+	rnq.ctx.Offset = &offset
+	return rnq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rnq *RequestNonceQuery) Unique(unique bool) *RequestNonceQuery {
+	rnq.ctx.Unique = &unique
+	return rnq
+}
+
+// Order specifies how the records should be ordered.
+func (rnq *RequestNonceQuery) Order(o ...requestnonce.OrderOption) *RequestNonceQuery {
+	rnq.order = append(rnq.order, o...)
+	return rnq
+}
+
+// QueryGame chains the current query on the "game" edge.
+func (rnq *RequestNonceQuery) QueryGame() *GameQuery {
+	query := (&GameClient{config: rnq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rnq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rnq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(requestnonce.Table, requestnonce.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, requestnonce.GameTable, requestnonce.GameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rnq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RequestNonce entity from the query.
+// Returns a *NotFoundError when no RequestNonce was found.
+func (rnq *RequestNonceQuery) First(ctx context.Context) (*RequestNonce, error) {
+	nodes, err := rnq.Limit(1).All(setContextOp(ctx, rnq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{requestnonce.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rnq *RequestNonceQuery) FirstX(ctx context.Context) *RequestNonce {
+	node, err := rnq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RequestNonce ID from the query.
+// Returns a *NotFoundError when no RequestNonce ID was found.
+func (rnq *RequestNonceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rnq.Limit(1).IDs(setContextOp(ctx, rnq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{requestnonce.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rnq *RequestNonceQuery) FirstIDX(ctx context.Context) int {
+	id, err := rnq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RequestNonce entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RequestNonce entity is found.
+// Returns a *NotFoundError when no RequestNonce entities are found.
+func (rnq *RequestNonceQuery) Only(ctx context.Context) (*RequestNonce, error) {
+	nodes, err := rnq.Limit(2).All(setContextOp(ctx, rnq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{requestnonce.Label}
+	default:
+		return nil, &NotSingularError{requestnonce.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rnq *RequestNonceQuery) OnlyX(ctx context.Context) *RequestNonce {
+	node, err := rnq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RequestNonce ID in the query.
+// Returns a *NotSingularError when more than one RequestNonce ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rnq *RequestNonceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rnq.Limit(2).IDs(setContextOp(ctx, rnq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{requestnonce.Label}
+	default:
+		err = &NotSingularError{requestnonce.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rnq *RequestNonceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rnq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RequestNonces.
+func (rnq *RequestNonceQuery) All(ctx context.Context) ([]*RequestNonce, error) {
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryAll)
+	if err := rnq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RequestNonce, *RequestNonceQuery]()
+	return withInterceptors[[]*RequestNonce](ctx, rnq, qr, rnq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rnq *RequestNonceQuery) AllX(ctx context.Context) []*RequestNonce {
+	nodes, err := rnq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RequestNonce IDs.
+func (rnq *RequestNonceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rnq.ctx.Unique == nil && rnq.path != nil {
+		rnq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryIDs)
+	if err = rnq.Select(requestnonce.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rnq *RequestNonceQuery) IDsX(ctx context.Context) []int {
+	ids, err := rnq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rnq *RequestNonceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryCount)
+	if err := rnq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rnq, querierCount[*RequestNonceQuery](), rnq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rnq *RequestNonceQuery) CountX(ctx context.Context) int {
+	count, err := rnq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rnq *RequestNonceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rnq.ctx, ent.OpQueryExist)
+	switch _, err := rnq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rnq *RequestNonceQuery) ExistX(ctx context.Context) bool {
+	exist, err := rnq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RequestNonceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rnq *RequestNonceQuery) Clone() *RequestNonceQuery {
+	if rnq == nil {
+		return nil
+	}
+	return &RequestNonceQuery{
+		config:     rnq.config,
+		ctx:        rnq.ctx.Clone(),
+		order:      append([]requestnonce.OrderOption{}, rnq.order...),
+		inters:     append([]Interceptor{}, rnq.inters...),
+		predicates: append([]predicate.RequestNonce{}, rnq.predicates...),
+		withGame:   rnq.withGame.Clone(),
+		// clone intermediate query.
+		sql:       rnq.sql.Clone(),
+		path:      rnq.path,
+		modifiers: append([]func(*sql.Selector){}, rnq.modifiers...),
+	}
+}
+
+// WithGame tells the query-builder to eager-load the nodes that are connected to
+// the "game" edge. The optional arguments are used to configure the query builder of the edge.
+func (rnq *RequestNonceQuery) WithGame(opts ...func(*GameQuery)) *RequestNonceQuery {
+	query := (&GameClient{config: rnq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rnq.withGame = query
+	return rnq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Nonce string `json:"nonce,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RequestNonce.Query().
+//		GroupBy(requestnonce.FieldNonce).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rnq *RequestNonceQuery) GroupBy(field string, fields ...string) *RequestNonceGroupBy {
+	rnq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RequestNonceGroupBy{build: rnq}
+	grbuild.flds = &rnq.ctx.Fields
+	grbuild.label = requestnonce.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Nonce string `json:"nonce,omitempty"`
+//	}
+//
+//	client.RequestNonce.Query().
+//		Select(requestnonce.FieldNonce).
+//		Scan(ctx, &v)
+func (rnq *RequestNonceQuery) Select(fields ...string) *RequestNonceSelect {
+	rnq.ctx.Fields = append(rnq.ctx.Fields, fields...)
+	sbuild := &RequestNonceSelect{RequestNonceQuery: rnq}
+	sbuild.label = requestnonce.Label
+	sbuild.flds, sbuild.scan = &rnq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RequestNonceSelect configured with the given aggregations.
+func (rnq *RequestNonceQuery) Aggregate(fns ...AggregateFunc) *RequestNonceSelect {
+	return rnq.Select().Aggregate(fns...)
+}
+
+func (rnq *RequestNonceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rnq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rnq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rnq.ctx.Fields {
+		if !requestnonce.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rnq.path != nil {
+		prev, err := rnq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rnq.sql = prev
+	}
+	return nil
+}
+
+func (rnq *RequestNonceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RequestNonce, error) {
+	var (
+		nodes       = []*RequestNonce{}
+		withFKs     = rnq.withFKs
+		_spec       = rnq.querySpec()
+		loadedTypes = [1]bool{
+			rnq.withGame != nil,
+		}
+	)
+	if rnq.withGame != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, requestnonce.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RequestNonce).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RequestNonce{config: rnq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rnq.modifiers) > 0 {
+		_spec.Modifiers = rnq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rnq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rnq.withGame; query != nil {
+		if err := rnq.loadGame(ctx, query, nodes, nil,
+			func(n *RequestNonce, e *Game) { n.Edges.Game = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rnq *RequestNonceQuery) loadGame(ctx context.Context, query *GameQuery, nodes []*RequestNonce, init func(*RequestNonce), assign func(*RequestNonce, *Game)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RequestNonce)
+	for i := range nodes {
+		if nodes[i].game_request_nonces == nil {
+			continue
+		}
+		fk := *nodes[i].game_request_nonces
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(game.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "game_request_nonces" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rnq *RequestNonceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rnq.querySpec()
+	if len(rnq.modifiers) > 0 {
+		_spec.Modifiers = rnq.modifiers
+	}
+	_spec.Node.Columns = rnq.ctx.Fields
+	if len(rnq.ctx.Fields) > 0 {
+		_spec.Unique = rnq.ctx.Unique != nil && *rnq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rnq.driver, _spec)
+}
+
+func (rnq *RequestNonceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(requestnonce.Table, requestnonce.Columns, sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt))
+	_spec.From = rnq.sql
+	if unique := rnq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rnq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rnq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requestnonce.FieldID)
+		for i := range fields {
+			if fields[i] != requestnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rnq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rnq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rnq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rnq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rnq *RequestNonceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rnq.driver.Dialect())
+	t1 := builder.Table(requestnonce.Table)
+	columns := rnq.ctx.Fields
+	if len(columns) == 0 {
+		columns = requestnonce.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rnq.sql != nil {
+		selector = rnq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rnq.ctx.Unique != nil && *rnq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range rnq.modifiers {
+		m(selector)
+	}
+	for _, p := range rnq.predicates {
+		p(selector)
+	}
+	for _, p := range rnq.order {
+		p(selector)
+	}
+	if offset := rnq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rnq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rnq *RequestNonceQuery) Modify(modifiers ...func(s *sql.Selector)) *RequestNonceSelect {
+	rnq.modifiers = append(rnq.modifiers, modifiers...)
+	return rnq.Select()
+}
+
+// RequestNonceGroupBy is the group-by builder for RequestNonce entities.
+type RequestNonceGroupBy struct {
+	selector
+	build *RequestNonceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rngb *RequestNonceGroupBy) Aggregate(fns ...AggregateFunc) *RequestNonceGroupBy {
+	rngb.fns = append(rngb.fns, fns...)
+	return rngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rngb *RequestNonceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rngb.build.ctx, ent.OpQueryGroupBy)
+	if err := rngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RequestNonceQuery, *RequestNonceGroupBy](ctx, rngb.build, rngb, rngb.build.inters, v)
+}
+
+func (rngb *RequestNonceGroupBy) sqlScan(ctx context.Context, root *RequestNonceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rngb.fns))
+	for _, fn := range rngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rngb.flds)+len(rngb.fns))
+		for _, f := range *rngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RequestNonceSelect is the builder for selecting fields of RequestNonce entities.
+type RequestNonceSelect struct {
+	*RequestNonceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rns *RequestNonceSelect) Aggregate(fns ...AggregateFunc) *RequestNonceSelect {
+	rns.fns = append(rns.fns, fns...)
+	return rns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rns *RequestNonceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rns.ctx, ent.OpQuerySelect)
+	if err := rns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RequestNonceQuery, *RequestNonceSelect](ctx, rns.RequestNonceQuery, rns, rns.inters, v)
+}
+
+func (rns *RequestNonceSelect) sqlScan(ctx context.Context, root *RequestNonceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rns.fns))
+	for _, fn := range rns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rns *RequestNonceSelect) Modify(modifiers ...func(s *sql.Selector)) *RequestNonceSelect {
+	rns.modifiers = append(rns.modifiers, modifiers...)
+	return rns
+}
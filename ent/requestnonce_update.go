@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/predicate"
+	"game-scores/ent/requestnonce"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RequestNonceUpdate is the builder for updating RequestNonce entities.
+type RequestNonceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RequestNonceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RequestNonceUpdate builder.
+func (rnu *RequestNonceUpdate) Where(ps ...predicate.RequestNonce) *RequestNonceUpdate {
+	rnu.mutation.Where(ps...)
+	return rnu
+}
+
+// Mutation returns the RequestNonceMutation object of the builder.
+func (rnu *RequestNonceUpdate) Mutation() *RequestNonceMutation {
+	return rnu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rnu *RequestNonceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rnu.sqlSave, rnu.mutation, rnu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rnu *RequestNonceUpdate) SaveX(ctx context.Context) int {
+	affected, err := rnu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rnu *RequestNonceUpdate) Exec(ctx context.Context) error {
+	_, err := rnu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnu *RequestNonceUpdate) ExecX(ctx context.Context) {
+	if err := rnu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rnu *RequestNonceUpdate) check() error {
+	if rnu.mutation.GameCleared() && len(rnu.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RequestNonce.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rnu *RequestNonceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RequestNonceUpdate {
+	rnu.modifiers = append(rnu.modifiers, modifiers...)
+	return rnu
+}
+
+func (rnu *RequestNonceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rnu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requestnonce.Table, requestnonce.Columns, sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt))
+	if ps := rnu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(rnu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rnu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requestnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rnu.mutation.done = true
+	return n, nil
+}
+
+// RequestNonceUpdateOne is the builder for updating a single RequestNonce entity.
+type RequestNonceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RequestNonceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the RequestNonceMutation object of the builder.
+func (rnuo *RequestNonceUpdateOne) Mutation() *RequestNonceMutation {
+	return rnuo.mutation
+}
+
+// Where appends a list predicates to the RequestNonceUpdate builder.
+func (rnuo *RequestNonceUpdateOne) Where(ps ...predicate.RequestNonce) *RequestNonceUpdateOne {
+	rnuo.mutation.Where(ps...)
+	return rnuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rnuo *RequestNonceUpdateOne) Select(field string, fields ...string) *RequestNonceUpdateOne {
+	rnuo.fields = append([]string{field}, fields...)
+	return rnuo
+}
+
+// Save executes the query and returns the updated RequestNonce entity.
+func (rnuo *RequestNonceUpdateOne) Save(ctx context.Context) (*RequestNonce, error) {
+	return withHooks(ctx, rnuo.sqlSave, rnuo.mutation, rnuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rnuo *RequestNonceUpdateOne) SaveX(ctx context.Context) *RequestNonce {
+	node, err := rnuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rnuo *RequestNonceUpdateOne) Exec(ctx context.Context) error {
+	_, err := rnuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rnuo *RequestNonceUpdateOne) ExecX(ctx context.Context) {
+	if err := rnuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rnuo *RequestNonceUpdateOne) check() error {
+	if rnuo.mutation.GameCleared() && len(rnuo.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RequestNonce.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rnuo *RequestNonceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RequestNonceUpdateOne {
+	rnuo.modifiers = append(rnuo.modifiers, modifiers...)
+	return rnuo
+}
+
+func (rnuo *RequestNonceUpdateOne) sqlSave(ctx context.Context) (_node *RequestNonce, err error) {
+	if err := rnuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requestnonce.Table, requestnonce.Columns, sqlgraph.NewFieldSpec(requestnonce.FieldID, field.TypeInt))
+	id, ok := rnuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RequestNonce.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rnuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requestnonce.FieldID)
+		for _, f := range fields {
+			if !requestnonce.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != requestnonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rnuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(rnuo.modifiers...)
+	_node = &RequestNonce{config: rnuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rnuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requestnonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rnuo.mutation.done = true
+	return _node, nil
+}
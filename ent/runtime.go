@@ -4,8 +4,18 @@ package ent
 
 import (
 	"game-scores/ent/game"
+	"game-scores/ent/identity"
+	"game-scores/ent/loginattempt"
+	"game-scores/ent/passwordresettoken"
+	"game-scores/ent/recoverycode"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/requestnonce"
+	"game-scores/ent/revokedtoken"
 	"game-scores/ent/schema"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
+	"game-scores/ent/serverkey"
 	"game-scores/ent/user"
 	"time"
 
@@ -22,6 +32,80 @@ func init() {
 	gameDescName := gameFields[0].Descriptor()
 	// game.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	game.NameValidator = gameDescName.Validators[0].(func(string) error)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[0].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = identityDescProvider.Validators[0].(func(string) error)
+	// identityDescSubject is the schema descriptor for subject field.
+	identityDescSubject := identityFields[1].Descriptor()
+	// identity.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	identity.SubjectValidator = identityDescSubject.Validators[0].(func(string) error)
+	// identityDescCreatedAt is the schema descriptor for created_at field.
+	identityDescCreatedAt := identityFields[3].Descriptor()
+	// identity.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identity.DefaultCreatedAt = identityDescCreatedAt.Default.(func() time.Time)
+	// identityDescLastLoginAt is the schema descriptor for last_login_at field.
+	identityDescLastLoginAt := identityFields[4].Descriptor()
+	// identity.DefaultLastLoginAt holds the default value on creation for the last_login_at field.
+	identity.DefaultLastLoginAt = identityDescLastLoginAt.Default.(func() time.Time)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescAttemptedAt is the schema descriptor for attempted_at field.
+	loginattemptDescAttemptedAt := loginattemptFields[2].Descriptor()
+	// loginattempt.DefaultAttemptedAt holds the default value on creation for the attempted_at field.
+	loginattempt.DefaultAttemptedAt = loginattemptDescAttemptedAt.Default.(func() time.Time)
+	passwordresettokenFields := schema.PasswordResetToken{}.Fields()
+	_ = passwordresettokenFields
+	// passwordresettokenDescTokenHash is the schema descriptor for token_hash field.
+	passwordresettokenDescTokenHash := passwordresettokenFields[0].Descriptor()
+	// passwordresettoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passwordresettoken.TokenHashValidator = passwordresettokenDescTokenHash.Validators[0].(func(string) error)
+	// passwordresettokenDescCreatedAt is the schema descriptor for created_at field.
+	passwordresettokenDescCreatedAt := passwordresettokenFields[1].Descriptor()
+	// passwordresettoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordresettoken.DefaultCreatedAt = passwordresettokenDescCreatedAt.Default.(func() time.Time)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[0].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeFields[1].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescTwoFactor is the schema descriptor for two_factor field.
+	refreshtokenDescTwoFactor := refreshtokenFields[3].Descriptor()
+	// refreshtoken.DefaultTwoFactor holds the default value on creation for the two_factor field.
+	refreshtoken.DefaultTwoFactor = refreshtokenDescTwoFactor.Default.(bool)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	requestnonceFields := schema.RequestNonce{}.Fields()
+	_ = requestnonceFields
+	// requestnonceDescNonce is the schema descriptor for nonce field.
+	requestnonceDescNonce := requestnonceFields[0].Descriptor()
+	// requestnonce.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	requestnonce.NonceValidator = requestnonceDescNonce.Validators[0].(func(string) error)
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescJti is the schema descriptor for jti field.
+	revokedtokenDescJti := revokedtokenFields[0].Descriptor()
+	// revokedtoken.JtiValidator is a validator for the "jti" field. It is called by the builders before save.
+	revokedtoken.JtiValidator = revokedtokenDescJti.Validators[0].(func(string) error)
+	// revokedtokenDescRevokedAt is the schema descriptor for revoked_at field.
+	revokedtokenDescRevokedAt := revokedtokenFields[2].Descriptor()
+	// revokedtoken.DefaultRevokedAt holds the default value on creation for the revoked_at field.
+	revokedtoken.DefaultRevokedAt = revokedtokenDescRevokedAt.Default.(func() time.Time)
 	scoreFields := schema.Score{}.Fields()
 	_ = scoreFields
 	// scoreDescValue is the schema descriptor for value field.
@@ -34,6 +118,44 @@ func init() {
 	scoreDescCreatedAt := scoreFields[1].Descriptor()
 	// score.DefaultCreatedAt holds the default value on creation for the created_at field.
 	score.DefaultCreatedAt = scoreDescCreatedAt.Default.(func() time.Time)
+	scoresubmissionFields := schema.ScoreSubmission{}.Fields()
+	_ = scoresubmissionFields
+	// scoresubmissionDescValue is the schema descriptor for value field.
+	scoresubmissionDescValue := scoresubmissionFields[0].Descriptor()
+	// scoresubmission.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	scoresubmission.ValueValidator = scoresubmissionDescValue.Validators[0].(func(int64) error)
+	// scoresubmissionDescAccepted is the schema descriptor for accepted field.
+	scoresubmissionDescAccepted := scoresubmissionFields[1].Descriptor()
+	// scoresubmission.DefaultAccepted holds the default value on creation for the accepted field.
+	scoresubmission.DefaultAccepted = scoresubmissionDescAccepted.Default.(bool)
+	// scoresubmissionDescSubmittedAt is the schema descriptor for submitted_at field.
+	scoresubmissionDescSubmittedAt := scoresubmissionFields[2].Descriptor()
+	// scoresubmission.DefaultSubmittedAt holds the default value on creation for the submitted_at field.
+	scoresubmission.DefaultSubmittedAt = scoresubmissionDescSubmittedAt.Default.(func() time.Time)
+	seasonFields := schema.Season{}.Fields()
+	_ = seasonFields
+	// seasonDescName is the schema descriptor for name field.
+	seasonDescName := seasonFields[0].Descriptor()
+	// season.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	season.NameValidator = seasonDescName.Validators[0].(func(string) error)
+	serverkeyFields := schema.ServerKey{}.Fields()
+	_ = serverkeyFields
+	// serverkeyDescName is the schema descriptor for name field.
+	serverkeyDescName := serverkeyFields[0].Descriptor()
+	// serverkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	serverkey.NameValidator = serverkeyDescName.Validators[0].(func(string) error)
+	// serverkeyDescKeyHash is the schema descriptor for key_hash field.
+	serverkeyDescKeyHash := serverkeyFields[1].Descriptor()
+	// serverkey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	serverkey.KeyHashValidator = serverkeyDescKeyHash.Validators[0].(func(string) error)
+	// serverkeyDescPrefix is the schema descriptor for prefix field.
+	serverkeyDescPrefix := serverkeyFields[2].Descriptor()
+	// serverkey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	serverkey.PrefixValidator = serverkeyDescPrefix.Validators[0].(func(string) error)
+	// serverkeyDescCreatedAt is the schema descriptor for created_at field.
+	serverkeyDescCreatedAt := serverkeyFields[4].Descriptor()
+	// serverkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	serverkey.DefaultCreatedAt = serverkeyDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -41,15 +163,31 @@ func init() {
 	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
 	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
 	// userDescEmail is the schema descriptor for email field.
-	userDescEmail := userFields[2].Descriptor()
+	userDescEmail := userFields[4].Descriptor()
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[5].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
 	// userDescPasswordHash is the schema descriptor for password_hash field.
-	userDescPasswordHash := userFields[3].Descriptor()
+	userDescPasswordHash := userFields[6].Descriptor()
 	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
 	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescBanned is the schema descriptor for banned field.
+	userDescBanned := userFields[8].Descriptor()
+	// user.DefaultBanned holds the default value on creation for the banned field.
+	user.DefaultBanned = userDescBanned.Default.(bool)
+	// userDescTotpEnabled is the schema descriptor for totp_enabled field.
+	userDescTotpEnabled := userFields[11].Descriptor()
+	// user.DefaultTotpEnabled holds the default value on creation for the totp_enabled field.
+	user.DefaultTotpEnabled = userDescTotpEnabled.Default.(bool)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[12].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
 	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[1].Descriptor()
+	userDescID := userFields[3].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
 }
@@ -17,6 +17,15 @@ func (Game) Fields() []ent.Field {
 			NotEmpty(),
 		field.Text("description").
 			Optional(),
+		field.Enum("sort_order").
+			Values("descending", "ascending").
+			Default("descending"), // Descending: higher scores are better, ascending: lower scores are better
+		field.Enum("score_policy").
+			Values("best", "latest", "cumulative").
+			Default("best"), // How a submitted score changes the player's score: keep the best, keep the latest or add it up
+		field.String("signing_secret").
+			Optional().
+			Sensitive(), // When set, score submissions must be signed with it
 	}
 }
 
@@ -24,5 +33,20 @@ func (Game) Edges() []ent.Edge {
 	return []ent.Edge{
 		// Defines the one-to-many relationship: one Game can have many Scores.
 		edge.To("scores", Score.Type),
+		// Every score submitted, including the ones that did not beat the current score.
+		edge.To("submissions", ScoreSubmission.Type),
+		// Defines the one-to-many relationship: one Game can have many Seasons.
+		edge.To("seasons", Season.Type),
+		// The API keys of the game's dedicated servers.
+		edge.To("server_keys", ServerKey.Type),
+		// The nonces of the signed score submissions, to reject replayed requests.
+		edge.To("request_nonces", RequestNonce.Type),
+		// The user the game belongs to, who manages it and picks its maintainers.
+		edge.From("owner", User.Type).
+			Ref("owned_games").
+			Unique(),
+		// The users helping the owner manage the game.
+		edge.From("maintainers", User.Type).
+			Ref("maintained_games"),
 	}
 }
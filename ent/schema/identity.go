@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity is the account of a user at an OpenID Connect provider, e.g. a
+// console or Steam account, which the user logs in with instead of a password.
+type Identity struct {
+	ent.Schema
+}
+
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			NotEmpty().
+			Immutable(), // Name of the provider, as configured in OIDC_PROVIDERS
+		field.String("subject").
+			NotEmpty().
+			Immutable(), // The provider's ID of the account, the "sub" claim
+		field.String("email").
+			Optional(), // The email the provider shared at the last login
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_login_at").
+			Default(time.Now),
+	}
+}
+
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to User.
+		edge.From("user", User.Type).
+			Ref("identities").
+			Unique().
+			Required(),
+	}
+}
+
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		// An account of a provider logs in a single user.
+		index.Fields("provider", "subject").
+			Unique(),
+	}
+}
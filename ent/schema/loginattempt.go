@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LoginAttempt is a failed login. Failures are counted per username and per
+// client IP to slow down and lock out password guessing. Attempts are recorded
+// before their credentials are checked, and removed unless they fail, so that
+// concurrent attempts count too. A successful login clears the failures of its
+// username.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("username").
+			Immutable(), // As sent, the user may not exist
+		field.String("client_ip").
+			Immutable(),
+		field.Time("attempted_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		// Recent failures are counted by username and by client IP.
+		index.Fields("username", "attempted_at"),
+		index.Fields("client_ip", "attempted_at"),
+	}
+}
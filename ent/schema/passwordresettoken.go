@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordResetToken is a single-use token emailed to a user who forgot their
+// password. Only a hash of the token is stored.
+type PasswordResetToken struct {
+	ent.Schema
+}
+
+func (PasswordResetToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("used_at").
+			Optional().
+			Nillable(), // Set once the token resets the password, or another token of the user does
+	}
+}
+
+func (PasswordResetToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to User.
+		edge.From("user", User.Type).
+			Ref("password_reset_tokens").
+			Unique().
+			Required(),
+	}
+}
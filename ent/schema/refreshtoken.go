@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RefreshToken is a long-lived credential that is exchanged for new access
+// tokens. Only a hash of the token is stored. Tokens are rotated on every use,
+// and the tokens descending from the same login share a family, so reusing a
+// rotated token can revoke the whole session.
+type RefreshToken struct {
+	ent.Schema
+}
+
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Sensitive(),
+		field.UUID("family", uuid.UUID{}).
+			Immutable(),
+		field.String("device").
+			Optional(), // Label of the device the user logged in from, e.g. "Steam Deck"
+		field.Bool("two_factor").
+			Default(false).
+			Immutable(), // Whether the session logged in with a second factor
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(), // Set once the token is rotated or the session logs out
+	}
+}
+
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to User.
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// Sessions are revoked by family.
+		index.Fields("family"),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RequestNonce is the nonce of a signed score submission. Each nonce can only
+// be used once per game, so a captured request can not be replayed. It can be
+// deleted once the timestamp of its request is too old to be accepted.
+type RequestNonce struct {
+	ent.Schema
+}
+
+func (RequestNonce) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("nonce").
+			NotEmpty().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(), // When the request's timestamp is no longer accepted
+	}
+}
+
+func (RequestNonce) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to Game.
+		edge.From("game", Game.Type).
+			Ref("request_nonces").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (RequestNonce) Indexes() []ent.Index {
+	return []ent.Index{
+		// A nonce can only be used once per game.
+		index.Fields("nonce").
+			Edges("game").
+			Unique(),
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RevokedToken is an access token that must no longer be accepted, identified by
+// its jti claim. It can be deleted once the token has expired.
+type RevokedToken struct {
+	ent.Schema
+}
+
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			Unique().
+			NotEmpty().
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(), // When the token expires by itself
+		field.Time("revoked_at").
+			Default(time.Now).
+			Immutable(),
+		field.String("reason").
+			Optional(),
+	}
+}
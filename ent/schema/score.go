@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Score struct {
@@ -19,6 +21,9 @@ func (Score) Fields() []ent.Field {
 			Default(0), // Default score value is 0
 		field.Time("created_at").
 			Default(time.Now),
+		field.Time("achieved_at").
+			Optional().
+			Nillable(), // When the current value was submitted, nil until the first submission
 	}
 }
 
@@ -34,5 +39,26 @@ func (Score) Edges() []ent.Edge {
 			Ref("scores").
 			Unique(). // A score must belong to exactly one game.
 			Required(),
+		// Creates the many-to-one relationship back to Season.
+		// Scores submitted outside of any season have no season.
+		edge.From("season", Season.Type).
+			Ref("scores").
+			Unique(),
+		// The submissions applied to the score, windowed leaderboards are
+		// ranked from those submitted during the window.
+		edge.To("submissions", ScoreSubmission.Type),
+	}
+}
+
+func (Score) Indexes() []ent.Index {
+	return []ent.Index{
+		// A player has a single score per game and season.
+		index.Edges("user", "game", "season").
+			Unique(),
+		// NULLs are distinct in the index above, so it does not stop a player
+		// from having two scores outside of any season.
+		index.Edges("user", "game").
+			Unique().
+			Annotations(entsql.IndexWhere("season_scores IS NULL")),
 	}
 }
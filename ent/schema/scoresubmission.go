@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ScoreSubmission records every score a player submits to a game, whether or
+// not it became their personal best.
+type ScoreSubmission struct {
+	ent.Schema
+}
+
+func (ScoreSubmission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("value").
+			NonNegative().
+			Immutable(),
+		field.Bool("accepted").
+			Default(false), // Whether the submission became the player's score
+		field.Time("submitted_at").
+			Default(time.Now).
+			Immutable(),
+		field.String("client_ip").
+			Optional(),
+		field.String("user_agent").
+			Optional(),
+		field.JSON("metadata", map[string]string{}).
+			Optional(), // Free-form data sent by the game client, e.g. build or level
+	}
+}
+
+func (ScoreSubmission) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to User.
+		edge.From("user", User.Type).
+			Ref("submissions").
+			Unique().
+			Required(),
+		// Creates the many-to-one relationship back to Game.
+		edge.From("game", Game.Type).
+			Ref("submissions").
+			Unique().
+			Required(),
+		// The server key of the game server that submitted the score on behalf
+		// of the player, if it was not the player themselves.
+		edge.From("server_key", ServerKey.Type).
+			Ref("submissions").
+			Unique(),
+		// The score the submission was applied to. It is cleared when the score
+		// is removed, so its submissions no longer count on any leaderboard.
+		edge.From("score", Score.Type).
+			Ref("submissions").
+			Unique(),
+	}
+}
+
+func (ScoreSubmission) Indexes() []ent.Index {
+	return []ent.Index{
+		// History is always listed per player and game.
+		index.Edges("user", "game"),
+		// Windowed leaderboards aggregate the recent submissions of each score.
+		index.Fields("submitted_at").
+			Edges("score"),
+	}
+}
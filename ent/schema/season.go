@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Season is a period of time with its own leaderboard for a game. Scores
+// submitted while a season is active belong to it, and once it ends its
+// leaderboard is frozen.
+type Season struct {
+	ent.Schema
+}
+
+func (Season) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.Time("starts_at"),
+		field.Time("ends_at"),
+	}
+}
+
+func (Season) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to Game.
+		edge.From("game", Game.Type).
+			Ref("seasons").
+			Unique().
+			Required(),
+		// Defines the one-to-many relationship: one Season can have many Scores.
+		edge.To("scores", Score.Type),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ServerKey is an API key of a game's dedicated servers. Servers are trusted to
+// know the real score of a match, so a key can submit scores on behalf of any
+// player of its game. Only a hash of the key is stored.
+type ServerKey struct {
+	ent.Schema
+}
+
+func (ServerKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(), // Label of the servers using the key, e.g. "EU dedicated servers"
+		field.String("key_hash").
+			Unique().
+			NotEmpty().
+			Immutable().
+			Sensitive(),
+		field.String("prefix").
+			NotEmpty().
+			Immutable(), // Start of the key, so it can be recognized without storing it
+		field.Strings("permissions"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ServerKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Creates the many-to-one relationship back to Game, a key only works for its game.
+		edge.From("game", Game.Type).
+			Ref("server_keys").
+			Unique().
+			Required(),
+		// The scores submitted with the key.
+		edge.To("submissions", ScoreSubmission.Type),
+	}
+}
@@ -16,18 +16,39 @@ func (User) Fields() []ent.Field {
 		field.String("username").
 			Unique().
 			NotEmpty(),
+		field.String("username_key").
+			Unique().
+			Optional(), // The case-folded username, so "Admin" can not register once "admin" did
+		field.String("username_skeleton").
+			Unique().
+			Optional(), // The look-alike form of the username key, so "соре" in Cyrillic can not register once "cope" did
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
 		field.String("email").
 			Unique().
 			NotEmpty(),
+		field.Bool("email_verified").
+			Default(false), // Set once the user follows the link of the verification email
 		field.String("password_hash").
 			NotEmpty().
 			Sensitive(), // Prevents it from being exposed in logs
 		field.Enum("role").
-			Values("player", "admin").
+			Values("player", "moderator", "game_owner", "admin").
 			Default("player"),
+		field.Bool("banned").
+			Default(false), // Banned users can not log in, and their tokens are rejected
+		field.Time("tokens_valid_after").
+			Optional().
+			Nillable(), // Access tokens issued before this time are revoked
+		field.String("totp_secret").
+			Optional().
+			Nillable().
+			Sensitive(), // Set on enrollment, only used once confirmed
+		field.Bool("totp_enabled").
+			Default(false), // Logins require a TOTP code or a recovery code
+		field.Int64("totp_last_step").
+			Default(0), // Time step of the last TOTP code accepted, so codes can not be used twice
 	}
 }
 
@@ -35,5 +56,18 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		// Defines the one-to-many relationship: one User can have many Scores.
 		edge.To("scores", Score.Type),
+		// Every score submitted, including the ones that did not beat the current score.
+		edge.To("submissions", ScoreSubmission.Type),
+		// The refresh tokens of the user's sessions.
+		edge.To("refresh_tokens", RefreshToken.Type),
+		// The tokens emailed to reset the user's password.
+		edge.To("password_reset_tokens", PasswordResetToken.Type),
+		// The single-use codes logging in when the TOTP device is lost.
+		edge.To("recovery_codes", RecoveryCode.Type),
+		// The accounts at OpenID Connect providers the user logs in with.
+		edge.To("identities", Identity.Type),
+		// The games the user owns, and the games they help manage.
+		edge.To("owned_games", Game.Type),
+		edge.To("maintained_games", Game.Type),
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"game-scores/ent/game"
 	"game-scores/ent/score"
+	"game-scores/ent/season"
 	"game-scores/ent/user"
 	"strings"
 	"time"
@@ -24,12 +25,15 @@ type Score struct {
 	Value int64 `json:"value,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// AchievedAt holds the value of the "achieved_at" field.
+	AchievedAt *time.Time `json:"achieved_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ScoreQuery when eager-loading is set.
-	Edges        ScoreEdges `json:"edges"`
-	game_scores  *int
-	user_scores  *uuid.UUID
-	selectValues sql.SelectValues
+	Edges         ScoreEdges `json:"edges"`
+	game_scores   *int
+	season_scores *int
+	user_scores   *uuid.UUID
+	selectValues  sql.SelectValues
 }
 
 // ScoreEdges holds the relations/edges for other nodes in the graph.
@@ -38,9 +42,13 @@ type ScoreEdges struct {
 	User *User `json:"user,omitempty"`
 	// Game holds the value of the game edge.
 	Game *Game `json:"game,omitempty"`
+	// Season holds the value of the season edge.
+	Season *Season `json:"season,omitempty"`
+	// Submissions holds the value of the submissions edge.
+	Submissions []*ScoreSubmission `json:"submissions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -65,6 +73,26 @@ func (e ScoreEdges) GameOrErr() (*Game, error) {
 	return nil, &NotLoadedError{edge: "game"}
 }
 
+// SeasonOrErr returns the Season value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreEdges) SeasonOrErr() (*Season, error) {
+	if e.Season != nil {
+		return e.Season, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: season.Label}
+	}
+	return nil, &NotLoadedError{edge: "season"}
+}
+
+// SubmissionsOrErr returns the Submissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScoreEdges) SubmissionsOrErr() ([]*ScoreSubmission, error) {
+	if e.loadedTypes[3] {
+		return e.Submissions, nil
+	}
+	return nil, &NotLoadedError{edge: "submissions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Score) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -72,11 +100,13 @@ func (*Score) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case score.FieldID, score.FieldValue:
 			values[i] = new(sql.NullInt64)
-		case score.FieldCreatedAt:
+		case score.FieldCreatedAt, score.FieldAchievedAt:
 			values[i] = new(sql.NullTime)
 		case score.ForeignKeys[0]: // game_scores
 			values[i] = new(sql.NullInt64)
-		case score.ForeignKeys[1]: // user_scores
+		case score.ForeignKeys[1]: // season_scores
+			values[i] = new(sql.NullInt64)
+		case score.ForeignKeys[2]: // user_scores
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -111,6 +141,13 @@ func (s *Score) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.CreatedAt = value.Time
 			}
+		case score.FieldAchievedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field achieved_at", values[i])
+			} else if value.Valid {
+				s.AchievedAt = new(time.Time)
+				*s.AchievedAt = value.Time
+			}
 		case score.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field game_scores", value)
@@ -119,6 +156,13 @@ func (s *Score) assignValues(columns []string, values []any) error {
 				*s.game_scores = int(value.Int64)
 			}
 		case score.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field season_scores", value)
+			} else if value.Valid {
+				s.season_scores = new(int)
+				*s.season_scores = int(value.Int64)
+			}
+		case score.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_scores", values[i])
 			} else if value.Valid {
@@ -148,6 +192,16 @@ func (s *Score) QueryGame() *GameQuery {
 	return NewScoreClient(s.config).QueryGame(s)
 }
 
+// QuerySeason queries the "season" edge of the Score entity.
+func (s *Score) QuerySeason() *SeasonQuery {
+	return NewScoreClient(s.config).QuerySeason(s)
+}
+
+// QuerySubmissions queries the "submissions" edge of the Score entity.
+func (s *Score) QuerySubmissions() *ScoreSubmissionQuery {
+	return NewScoreClient(s.config).QuerySubmissions(s)
+}
+
 // Update returns a builder for updating this Score.
 // Note that you need to call Score.Unwrap() before calling this method if this Score
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -176,6 +230,11 @@ func (s *Score) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.AchievedAt; v != nil {
+		builder.WriteString("achieved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
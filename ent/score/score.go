@@ -18,10 +18,16 @@ const (
 	FieldValue = "value"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldAchievedAt holds the string denoting the achieved_at field in the database.
+	FieldAchievedAt = "achieved_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeGame holds the string denoting the game edge name in mutations.
 	EdgeGame = "game"
+	// EdgeSeason holds the string denoting the season edge name in mutations.
+	EdgeSeason = "season"
+	// EdgeSubmissions holds the string denoting the submissions edge name in mutations.
+	EdgeSubmissions = "submissions"
 	// Table holds the table name of the score in the database.
 	Table = "scores"
 	// UserTable is the table that holds the user relation/edge.
@@ -38,6 +44,20 @@ const (
 	GameInverseTable = "games"
 	// GameColumn is the table column denoting the game relation/edge.
 	GameColumn = "game_scores"
+	// SeasonTable is the table that holds the season relation/edge.
+	SeasonTable = "scores"
+	// SeasonInverseTable is the table name for the Season entity.
+	// It exists in this package in order to avoid circular dependency with the "season" package.
+	SeasonInverseTable = "seasons"
+	// SeasonColumn is the table column denoting the season relation/edge.
+	SeasonColumn = "season_scores"
+	// SubmissionsTable is the table that holds the submissions relation/edge.
+	SubmissionsTable = "score_submissions"
+	// SubmissionsInverseTable is the table name for the ScoreSubmission entity.
+	// It exists in this package in order to avoid circular dependency with the "scoresubmission" package.
+	SubmissionsInverseTable = "score_submissions"
+	// SubmissionsColumn is the table column denoting the submissions relation/edge.
+	SubmissionsColumn = "score_submissions"
 )
 
 // Columns holds all SQL columns for score fields.
@@ -45,12 +65,14 @@ var Columns = []string{
 	FieldID,
 	FieldValue,
 	FieldCreatedAt,
+	FieldAchievedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "scores"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"game_scores",
+	"season_scores",
 	"user_scores",
 }
 
@@ -96,6 +118,11 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByAchievedAt orders the results by the achieved_at field.
+func ByAchievedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAchievedAt, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -109,6 +136,27 @@ func ByGameField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newGameStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySeasonField orders the results by season field.
+func BySeasonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeasonStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySubmissionsCount orders the results by submissions count.
+func BySubmissionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSubmissionsStep(), opts...)
+	}
+}
+
+// BySubmissions orders the results by submissions terms.
+func BySubmissions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSubmissionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -123,3 +171,17 @@ func newGameStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
 	)
 }
+func newSeasonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeasonInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+	)
+}
+func newSubmissionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SubmissionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SubmissionsTable, SubmissionsColumn),
+	)
+}
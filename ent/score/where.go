@@ -65,6 +65,11 @@ func CreatedAt(v time.Time) predicate.Score {
 	return predicate.Score(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// AchievedAt applies equality check predicate on the "achieved_at" field. It's identical to AchievedAtEQ.
+func AchievedAt(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldEQ(FieldAchievedAt, v))
+}
+
 // ValueEQ applies the EQ predicate on the "value" field.
 func ValueEQ(v int64) predicate.Score {
 	return predicate.Score(sql.FieldEQ(FieldValue, v))
@@ -145,6 +150,56 @@ func CreatedAtLTE(v time.Time) predicate.Score {
 	return predicate.Score(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// AchievedAtEQ applies the EQ predicate on the "achieved_at" field.
+func AchievedAtEQ(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldEQ(FieldAchievedAt, v))
+}
+
+// AchievedAtNEQ applies the NEQ predicate on the "achieved_at" field.
+func AchievedAtNEQ(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldNEQ(FieldAchievedAt, v))
+}
+
+// AchievedAtIn applies the In predicate on the "achieved_at" field.
+func AchievedAtIn(vs ...time.Time) predicate.Score {
+	return predicate.Score(sql.FieldIn(FieldAchievedAt, vs...))
+}
+
+// AchievedAtNotIn applies the NotIn predicate on the "achieved_at" field.
+func AchievedAtNotIn(vs ...time.Time) predicate.Score {
+	return predicate.Score(sql.FieldNotIn(FieldAchievedAt, vs...))
+}
+
+// AchievedAtGT applies the GT predicate on the "achieved_at" field.
+func AchievedAtGT(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldGT(FieldAchievedAt, v))
+}
+
+// AchievedAtGTE applies the GTE predicate on the "achieved_at" field.
+func AchievedAtGTE(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldGTE(FieldAchievedAt, v))
+}
+
+// AchievedAtLT applies the LT predicate on the "achieved_at" field.
+func AchievedAtLT(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldLT(FieldAchievedAt, v))
+}
+
+// AchievedAtLTE applies the LTE predicate on the "achieved_at" field.
+func AchievedAtLTE(v time.Time) predicate.Score {
+	return predicate.Score(sql.FieldLTE(FieldAchievedAt, v))
+}
+
+// AchievedAtIsNil applies the IsNil predicate on the "achieved_at" field.
+func AchievedAtIsNil() predicate.Score {
+	return predicate.Score(sql.FieldIsNull(FieldAchievedAt))
+}
+
+// AchievedAtNotNil applies the NotNil predicate on the "achieved_at" field.
+func AchievedAtNotNil() predicate.Score {
+	return predicate.Score(sql.FieldNotNull(FieldAchievedAt))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.Score {
 	return predicate.Score(func(s *sql.Selector) {
@@ -191,6 +246,52 @@ func HasGameWith(preds ...predicate.Game) predicate.Score {
 	})
 }
 
+// HasSeason applies the HasEdge predicate on the "season" edge.
+func HasSeason() predicate.Score {
+	return predicate.Score(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeasonTable, SeasonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeasonWith applies the HasEdge predicate on the "season" edge with a given conditions (other predicates).
+func HasSeasonWith(preds ...predicate.Season) predicate.Score {
+	return predicate.Score(func(s *sql.Selector) {
+		step := newSeasonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSubmissions applies the HasEdge predicate on the "submissions" edge.
+func HasSubmissions() predicate.Score {
+	return predicate.Score(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SubmissionsTable, SubmissionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSubmissionsWith applies the HasEdge predicate on the "submissions" edge with a given conditions (other predicates).
+func HasSubmissionsWith(preds ...predicate.ScoreSubmission) predicate.Score {
+	return predicate.Score(func(s *sql.Selector) {
+		step := newSubmissionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Score) predicate.Score {
 	return predicate.Score(sql.AndPredicates(predicates...))
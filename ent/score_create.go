@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"game-scores/ent/game"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
 	"game-scores/ent/user"
 	"time"
 
@@ -51,6 +53,20 @@ func (sc *ScoreCreate) SetNillableCreatedAt(t *time.Time) *ScoreCreate {
 	return sc
 }
 
+// SetAchievedAt sets the "achieved_at" field.
+func (sc *ScoreCreate) SetAchievedAt(t time.Time) *ScoreCreate {
+	sc.mutation.SetAchievedAt(t)
+	return sc
+}
+
+// SetNillableAchievedAt sets the "achieved_at" field if the given value is not nil.
+func (sc *ScoreCreate) SetNillableAchievedAt(t *time.Time) *ScoreCreate {
+	if t != nil {
+		sc.SetAchievedAt(*t)
+	}
+	return sc
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (sc *ScoreCreate) SetUserID(id uuid.UUID) *ScoreCreate {
 	sc.mutation.SetUserID(id)
@@ -73,6 +89,40 @@ func (sc *ScoreCreate) SetGame(g *Game) *ScoreCreate {
 	return sc.SetGameID(g.ID)
 }
 
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (sc *ScoreCreate) SetSeasonID(id int) *ScoreCreate {
+	sc.mutation.SetSeasonID(id)
+	return sc
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (sc *ScoreCreate) SetNillableSeasonID(id *int) *ScoreCreate {
+	if id != nil {
+		sc = sc.SetSeasonID(*id)
+	}
+	return sc
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (sc *ScoreCreate) SetSeason(s *Season) *ScoreCreate {
+	return sc.SetSeasonID(s.ID)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (sc *ScoreCreate) AddSubmissionIDs(ids ...int) *ScoreCreate {
+	sc.mutation.AddSubmissionIDs(ids...)
+	return sc
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (sc *ScoreCreate) AddSubmissions(s ...*ScoreSubmission) *ScoreCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddSubmissionIDs(ids...)
+}
+
 // Mutation returns the ScoreMutation object of the builder.
 func (sc *ScoreCreate) Mutation() *ScoreMutation {
 	return sc.mutation
@@ -171,6 +221,10 @@ func (sc *ScoreCreate) createSpec() (*Score, *sqlgraph.CreateSpec) {
 		_spec.SetField(score.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := sc.mutation.AchievedAt(); ok {
+		_spec.SetField(score.FieldAchievedAt, field.TypeTime, value)
+		_node.AchievedAt = &value
+	}
 	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -205,6 +259,39 @@ func (sc *ScoreCreate) createSpec() (*Score, *sqlgraph.CreateSpec) {
 		_node.game_scores = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   score.SeasonTable,
+			Columns: []string{score.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.season_scores = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,10 +4,13 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"game-scores/ent/game"
 	"game-scores/ent/predicate"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
 	"game-scores/ent/user"
 	"math"
 
@@ -21,13 +24,16 @@ import (
 // ScoreQuery is the builder for querying Score entities.
 type ScoreQuery struct {
 	config
-	ctx        *QueryContext
-	order      []score.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Score
-	withUser   *UserQuery
-	withGame   *GameQuery
-	withFKs    bool
+	ctx             *QueryContext
+	order           []score.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Score
+	withUser        *UserQuery
+	withGame        *GameQuery
+	withSeason      *SeasonQuery
+	withSubmissions *ScoreSubmissionQuery
+	withFKs         bool
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +114,50 @@ func (sq *ScoreQuery) QueryGame() *GameQuery {
 	return query
 }
 
+// QuerySeason chains the current query on the "season" edge.
+func (sq *ScoreQuery) QuerySeason() *SeasonQuery {
+	query := (&SeasonClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(score.Table, score.FieldID, selector),
+			sqlgraph.To(season.Table, season.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, score.SeasonTable, score.SeasonColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubmissions chains the current query on the "submissions" edge.
+func (sq *ScoreQuery) QuerySubmissions() *ScoreSubmissionQuery {
+	query := (&ScoreSubmissionClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(score.Table, score.FieldID, selector),
+			sqlgraph.To(scoresubmission.Table, scoresubmission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, score.SubmissionsTable, score.SubmissionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Score entity from the query.
 // Returns a *NotFoundError when no Score was found.
 func (sq *ScoreQuery) First(ctx context.Context) (*Score, error) {
@@ -295,16 +345,19 @@ func (sq *ScoreQuery) Clone() *ScoreQuery {
 		return nil
 	}
 	return &ScoreQuery{
-		config:     sq.config,
-		ctx:        sq.ctx.Clone(),
-		order:      append([]score.OrderOption{}, sq.order...),
-		inters:     append([]Interceptor{}, sq.inters...),
-		predicates: append([]predicate.Score{}, sq.predicates...),
-		withUser:   sq.withUser.Clone(),
-		withGame:   sq.withGame.Clone(),
+		config:          sq.config,
+		ctx:             sq.ctx.Clone(),
+		order:           append([]score.OrderOption{}, sq.order...),
+		inters:          append([]Interceptor{}, sq.inters...),
+		predicates:      append([]predicate.Score{}, sq.predicates...),
+		withUser:        sq.withUser.Clone(),
+		withGame:        sq.withGame.Clone(),
+		withSeason:      sq.withSeason.Clone(),
+		withSubmissions: sq.withSubmissions.Clone(),
 		// clone intermediate query.
-		sql:  sq.sql.Clone(),
-		path: sq.path,
+		sql:       sq.sql.Clone(),
+		path:      sq.path,
+		modifiers: append([]func(*sql.Selector){}, sq.modifiers...),
 	}
 }
 
@@ -330,6 +383,28 @@ func (sq *ScoreQuery) WithGame(opts ...func(*GameQuery)) *ScoreQuery {
 	return sq
 }
 
+// WithSeason tells the query-builder to eager-load the nodes that are connected to
+// the "season" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ScoreQuery) WithSeason(opts ...func(*SeasonQuery)) *ScoreQuery {
+	query := (&SeasonClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withSeason = query
+	return sq
+}
+
+// WithSubmissions tells the query-builder to eager-load the nodes that are connected to
+// the "submissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *ScoreQuery) WithSubmissions(opts ...func(*ScoreSubmissionQuery)) *ScoreQuery {
+	query := (&ScoreSubmissionClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withSubmissions = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +484,14 @@ func (sq *ScoreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Score,
 		nodes       = []*Score{}
 		withFKs     = sq.withFKs
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			sq.withUser != nil,
 			sq.withGame != nil,
+			sq.withSeason != nil,
+			sq.withSubmissions != nil,
 		}
 	)
-	if sq.withUser != nil || sq.withGame != nil {
+	if sq.withUser != nil || sq.withGame != nil || sq.withSeason != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -429,6 +506,9 @@ func (sq *ScoreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Score,
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -450,6 +530,19 @@ func (sq *ScoreQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Score,
 			return nil, err
 		}
 	}
+	if query := sq.withSeason; query != nil {
+		if err := sq.loadSeason(ctx, query, nodes, nil,
+			func(n *Score, e *Season) { n.Edges.Season = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := sq.withSubmissions; query != nil {
+		if err := sq.loadSubmissions(ctx, query, nodes,
+			func(n *Score) { n.Edges.Submissions = []*ScoreSubmission{} },
+			func(n *Score, e *ScoreSubmission) { n.Edges.Submissions = append(n.Edges.Submissions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,9 +610,75 @@ func (sq *ScoreQuery) loadGame(ctx context.Context, query *GameQuery, nodes []*S
 	}
 	return nil
 }
+func (sq *ScoreQuery) loadSeason(ctx context.Context, query *SeasonQuery, nodes []*Score, init func(*Score), assign func(*Score, *Season)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Score)
+	for i := range nodes {
+		if nodes[i].season_scores == nil {
+			continue
+		}
+		fk := *nodes[i].season_scores
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(season.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "season_scores" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (sq *ScoreQuery) loadSubmissions(ctx context.Context, query *ScoreSubmissionQuery, nodes []*Score, init func(*Score), assign func(*Score, *ScoreSubmission)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Score)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ScoreSubmission(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(score.SubmissionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.score_submissions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "score_submissions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "score_submissions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *ScoreQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
+	if len(sq.modifiers) > 0 {
+		_spec.Modifiers = sq.modifiers
+	}
 	_spec.Node.Columns = sq.ctx.Fields
 	if len(sq.ctx.Fields) > 0 {
 		_spec.Unique = sq.ctx.Unique != nil && *sq.ctx.Unique
@@ -582,6 +741,9 @@ func (sq *ScoreQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if sq.ctx.Unique != nil && *sq.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range sq.modifiers {
+		m(selector)
+	}
 	for _, p := range sq.predicates {
 		p(selector)
 	}
@@ -599,6 +761,12 @@ func (sq *ScoreQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sq *ScoreQuery) Modify(modifiers ...func(s *sql.Selector)) *ScoreSelect {
+	sq.modifiers = append(sq.modifiers, modifiers...)
+	return sq.Select()
+}
+
 // ScoreGroupBy is the group-by builder for Score entities.
 type ScoreGroupBy struct {
 	selector
@@ -688,3 +856,9 @@ func (ss *ScoreSelect) sqlScan(ctx context.Context, root *ScoreQuery, v any) err
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ss *ScoreSelect) Modify(modifiers ...func(s *sql.Selector)) *ScoreSelect {
+	ss.modifiers = append(ss.modifiers, modifiers...)
+	return ss
+}
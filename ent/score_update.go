@@ -9,6 +9,8 @@ import (
 	"game-scores/ent/game"
 	"game-scores/ent/predicate"
 	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
 	"game-scores/ent/user"
 	"time"
 
@@ -21,8 +23,9 @@ import (
 // ScoreUpdate is the builder for updating Score entities.
 type ScoreUpdate struct {
 	config
-	hooks    []Hook
-	mutation *ScoreMutation
+	hooks     []Hook
+	mutation  *ScoreMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the ScoreUpdate builder.
@@ -66,6 +69,26 @@ func (su *ScoreUpdate) SetNillableCreatedAt(t *time.Time) *ScoreUpdate {
 	return su
 }
 
+// SetAchievedAt sets the "achieved_at" field.
+func (su *ScoreUpdate) SetAchievedAt(t time.Time) *ScoreUpdate {
+	su.mutation.SetAchievedAt(t)
+	return su
+}
+
+// SetNillableAchievedAt sets the "achieved_at" field if the given value is not nil.
+func (su *ScoreUpdate) SetNillableAchievedAt(t *time.Time) *ScoreUpdate {
+	if t != nil {
+		su.SetAchievedAt(*t)
+	}
+	return su
+}
+
+// ClearAchievedAt clears the value of the "achieved_at" field.
+func (su *ScoreUpdate) ClearAchievedAt() *ScoreUpdate {
+	su.mutation.ClearAchievedAt()
+	return su
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (su *ScoreUpdate) SetUserID(id uuid.UUID) *ScoreUpdate {
 	su.mutation.SetUserID(id)
@@ -88,6 +111,40 @@ func (su *ScoreUpdate) SetGame(g *Game) *ScoreUpdate {
 	return su.SetGameID(g.ID)
 }
 
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (su *ScoreUpdate) SetSeasonID(id int) *ScoreUpdate {
+	su.mutation.SetSeasonID(id)
+	return su
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (su *ScoreUpdate) SetNillableSeasonID(id *int) *ScoreUpdate {
+	if id != nil {
+		su = su.SetSeasonID(*id)
+	}
+	return su
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (su *ScoreUpdate) SetSeason(s *Season) *ScoreUpdate {
+	return su.SetSeasonID(s.ID)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (su *ScoreUpdate) AddSubmissionIDs(ids ...int) *ScoreUpdate {
+	su.mutation.AddSubmissionIDs(ids...)
+	return su
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (su *ScoreUpdate) AddSubmissions(s ...*ScoreSubmission) *ScoreUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddSubmissionIDs(ids...)
+}
+
 // Mutation returns the ScoreMutation object of the builder.
 func (su *ScoreUpdate) Mutation() *ScoreMutation {
 	return su.mutation
@@ -105,6 +162,33 @@ func (su *ScoreUpdate) ClearGame() *ScoreUpdate {
 	return su
 }
 
+// ClearSeason clears the "season" edge to the Season entity.
+func (su *ScoreUpdate) ClearSeason() *ScoreUpdate {
+	su.mutation.ClearSeason()
+	return su
+}
+
+// ClearSubmissions clears all "submissions" edges to the ScoreSubmission entity.
+func (su *ScoreUpdate) ClearSubmissions() *ScoreUpdate {
+	su.mutation.ClearSubmissions()
+	return su
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScoreSubmission entities by IDs.
+func (su *ScoreUpdate) RemoveSubmissionIDs(ids ...int) *ScoreUpdate {
+	su.mutation.RemoveSubmissionIDs(ids...)
+	return su
+}
+
+// RemoveSubmissions removes "submissions" edges to ScoreSubmission entities.
+func (su *ScoreUpdate) RemoveSubmissions(s ...*ScoreSubmission) *ScoreUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveSubmissionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *ScoreUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
@@ -148,6 +232,12 @@ func (su *ScoreUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *ScoreUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScoreUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
 func (su *ScoreUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if err := su.check(); err != nil {
 		return n, err
@@ -169,6 +259,12 @@ func (su *ScoreUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.CreatedAt(); ok {
 		_spec.SetField(score.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := su.mutation.AchievedAt(); ok {
+		_spec.SetField(score.FieldAchievedAt, field.TypeTime, value)
+	}
+	if su.mutation.AchievedAtCleared() {
+		_spec.ClearField(score.FieldAchievedAt, field.TypeTime)
+	}
 	if su.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -227,6 +323,81 @@ func (su *ScoreUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   score.SeasonTable,
+			Columns: []string{score.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   score.SeasonTable,
+			Columns: []string{score.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !su.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(su.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{score.Label}
@@ -242,9 +413,10 @@ func (su *ScoreUpdate) sqlSave(ctx context.Context) (n int, err error) {
 // ScoreUpdateOne is the builder for updating a single Score entity.
 type ScoreUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *ScoreMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *ScoreMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetValue sets the "value" field.
@@ -282,6 +454,26 @@ func (suo *ScoreUpdateOne) SetNillableCreatedAt(t *time.Time) *ScoreUpdateOne {
 	return suo
 }
 
+// SetAchievedAt sets the "achieved_at" field.
+func (suo *ScoreUpdateOne) SetAchievedAt(t time.Time) *ScoreUpdateOne {
+	suo.mutation.SetAchievedAt(t)
+	return suo
+}
+
+// SetNillableAchievedAt sets the "achieved_at" field if the given value is not nil.
+func (suo *ScoreUpdateOne) SetNillableAchievedAt(t *time.Time) *ScoreUpdateOne {
+	if t != nil {
+		suo.SetAchievedAt(*t)
+	}
+	return suo
+}
+
+// ClearAchievedAt clears the value of the "achieved_at" field.
+func (suo *ScoreUpdateOne) ClearAchievedAt() *ScoreUpdateOne {
+	suo.mutation.ClearAchievedAt()
+	return suo
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (suo *ScoreUpdateOne) SetUserID(id uuid.UUID) *ScoreUpdateOne {
 	suo.mutation.SetUserID(id)
@@ -304,6 +496,40 @@ func (suo *ScoreUpdateOne) SetGame(g *Game) *ScoreUpdateOne {
 	return suo.SetGameID(g.ID)
 }
 
+// SetSeasonID sets the "season" edge to the Season entity by ID.
+func (suo *ScoreUpdateOne) SetSeasonID(id int) *ScoreUpdateOne {
+	suo.mutation.SetSeasonID(id)
+	return suo
+}
+
+// SetNillableSeasonID sets the "season" edge to the Season entity by ID if the given value is not nil.
+func (suo *ScoreUpdateOne) SetNillableSeasonID(id *int) *ScoreUpdateOne {
+	if id != nil {
+		suo = suo.SetSeasonID(*id)
+	}
+	return suo
+}
+
+// SetSeason sets the "season" edge to the Season entity.
+func (suo *ScoreUpdateOne) SetSeason(s *Season) *ScoreUpdateOne {
+	return suo.SetSeasonID(s.ID)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (suo *ScoreUpdateOne) AddSubmissionIDs(ids ...int) *ScoreUpdateOne {
+	suo.mutation.AddSubmissionIDs(ids...)
+	return suo
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (suo *ScoreUpdateOne) AddSubmissions(s ...*ScoreSubmission) *ScoreUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddSubmissionIDs(ids...)
+}
+
 // Mutation returns the ScoreMutation object of the builder.
 func (suo *ScoreUpdateOne) Mutation() *ScoreMutation {
 	return suo.mutation
@@ -321,6 +547,33 @@ func (suo *ScoreUpdateOne) ClearGame() *ScoreUpdateOne {
 	return suo
 }
 
+// ClearSeason clears the "season" edge to the Season entity.
+func (suo *ScoreUpdateOne) ClearSeason() *ScoreUpdateOne {
+	suo.mutation.ClearSeason()
+	return suo
+}
+
+// ClearSubmissions clears all "submissions" edges to the ScoreSubmission entity.
+func (suo *ScoreUpdateOne) ClearSubmissions() *ScoreUpdateOne {
+	suo.mutation.ClearSubmissions()
+	return suo
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScoreSubmission entities by IDs.
+func (suo *ScoreUpdateOne) RemoveSubmissionIDs(ids ...int) *ScoreUpdateOne {
+	suo.mutation.RemoveSubmissionIDs(ids...)
+	return suo
+}
+
+// RemoveSubmissions removes "submissions" edges to ScoreSubmission entities.
+func (suo *ScoreUpdateOne) RemoveSubmissions(s ...*ScoreSubmission) *ScoreUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveSubmissionIDs(ids...)
+}
+
 // Where appends a list predicates to the ScoreUpdate builder.
 func (suo *ScoreUpdateOne) Where(ps ...predicate.Score) *ScoreUpdateOne {
 	suo.mutation.Where(ps...)
@@ -377,6 +630,12 @@ func (suo *ScoreUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *ScoreUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScoreUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
 func (suo *ScoreUpdateOne) sqlSave(ctx context.Context) (_node *Score, err error) {
 	if err := suo.check(); err != nil {
 		return _node, err
@@ -415,6 +674,12 @@ func (suo *ScoreUpdateOne) sqlSave(ctx context.Context) (_node *Score, err error
 	if value, ok := suo.mutation.CreatedAt(); ok {
 		_spec.SetField(score.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := suo.mutation.AchievedAt(); ok {
+		_spec.SetField(score.FieldAchievedAt, field.TypeTime, value)
+	}
+	if suo.mutation.AchievedAtCleared() {
+		_spec.ClearField(score.FieldAchievedAt, field.TypeTime)
+	}
 	if suo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -473,6 +738,81 @@ func (suo *ScoreUpdateOne) sqlSave(ctx context.Context) (_node *Score, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.SeasonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   score.SeasonTable,
+			Columns: []string{score.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SeasonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   score.SeasonTable,
+			Columns: []string{score.SeasonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !suo.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   score.SubmissionsTable,
+			Columns: []string{score.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(suo.modifiers...)
 	_node = &Score{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
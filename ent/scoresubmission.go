@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ScoreSubmission is the model entity for the ScoreSubmission schema.
+type ScoreSubmission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Value holds the value of the "value" field.
+	Value int64 `json:"value,omitempty"`
+	// Accepted holds the value of the "accepted" field.
+	Accepted bool `json:"accepted,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt time.Time `json:"submitted_at,omitempty"`
+	// ClientIP holds the value of the "client_ip" field.
+	ClientIP string `json:"client_ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScoreSubmissionQuery when eager-loading is set.
+	Edges                  ScoreSubmissionEdges `json:"edges"`
+	game_submissions       *int
+	score_submissions      *int
+	server_key_submissions *int
+	user_submissions       *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// ScoreSubmissionEdges holds the relations/edges for other nodes in the graph.
+type ScoreSubmissionEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// ServerKey holds the value of the server_key edge.
+	ServerKey *ServerKey `json:"server_key,omitempty"`
+	// Score holds the value of the score edge.
+	Score *Score `json:"score,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreSubmissionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreSubmissionEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// ServerKeyOrErr returns the ServerKey value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreSubmissionEdges) ServerKeyOrErr() (*ServerKey, error) {
+	if e.ServerKey != nil {
+		return e.ServerKey, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: serverkey.Label}
+	}
+	return nil, &NotLoadedError{edge: "server_key"}
+}
+
+// ScoreOrErr returns the Score value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScoreSubmissionEdges) ScoreOrErr() (*Score, error) {
+	if e.Score != nil {
+		return e.Score, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: score.Label}
+	}
+	return nil, &NotLoadedError{edge: "score"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScoreSubmission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scoresubmission.FieldMetadata:
+			values[i] = new([]byte)
+		case scoresubmission.FieldAccepted:
+			values[i] = new(sql.NullBool)
+		case scoresubmission.FieldID, scoresubmission.FieldValue:
+			values[i] = new(sql.NullInt64)
+		case scoresubmission.FieldClientIP, scoresubmission.FieldUserAgent:
+			values[i] = new(sql.NullString)
+		case scoresubmission.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		case scoresubmission.ForeignKeys[0]: // game_submissions
+			values[i] = new(sql.NullInt64)
+		case scoresubmission.ForeignKeys[1]: // score_submissions
+			values[i] = new(sql.NullInt64)
+		case scoresubmission.ForeignKeys[2]: // server_key_submissions
+			values[i] = new(sql.NullInt64)
+		case scoresubmission.ForeignKeys[3]: // user_submissions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScoreSubmission fields.
+func (ss *ScoreSubmission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scoresubmission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ss.ID = int(value.Int64)
+		case scoresubmission.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				ss.Value = value.Int64
+			}
+		case scoresubmission.FieldAccepted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field accepted", values[i])
+			} else if value.Valid {
+				ss.Accepted = value.Bool
+			}
+		case scoresubmission.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				ss.SubmittedAt = value.Time
+			}
+		case scoresubmission.FieldClientIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_ip", values[i])
+			} else if value.Valid {
+				ss.ClientIP = value.String
+			}
+		case scoresubmission.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				ss.UserAgent = value.String
+			}
+		case scoresubmission.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case scoresubmission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field game_submissions", value)
+			} else if value.Valid {
+				ss.game_submissions = new(int)
+				*ss.game_submissions = int(value.Int64)
+			}
+		case scoresubmission.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field score_submissions", value)
+			} else if value.Valid {
+				ss.score_submissions = new(int)
+				*ss.score_submissions = int(value.Int64)
+			}
+		case scoresubmission.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field server_key_submissions", value)
+			} else if value.Valid {
+				ss.server_key_submissions = new(int)
+				*ss.server_key_submissions = int(value.Int64)
+			}
+		case scoresubmission.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_submissions", values[i])
+			} else if value.Valid {
+				ss.user_submissions = new(uuid.UUID)
+				*ss.user_submissions = *value.S.(*uuid.UUID)
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the ScoreSubmission.
+// This includes values selected through modifiers, order, etc.
+func (ss *ScoreSubmission) GetValue(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ScoreSubmission entity.
+func (ss *ScoreSubmission) QueryUser() *UserQuery {
+	return NewScoreSubmissionClient(ss.config).QueryUser(ss)
+}
+
+// QueryGame queries the "game" edge of the ScoreSubmission entity.
+func (ss *ScoreSubmission) QueryGame() *GameQuery {
+	return NewScoreSubmissionClient(ss.config).QueryGame(ss)
+}
+
+// QueryServerKey queries the "server_key" edge of the ScoreSubmission entity.
+func (ss *ScoreSubmission) QueryServerKey() *ServerKeyQuery {
+	return NewScoreSubmissionClient(ss.config).QueryServerKey(ss)
+}
+
+// QueryScore queries the "score" edge of the ScoreSubmission entity.
+func (ss *ScoreSubmission) QueryScore() *ScoreQuery {
+	return NewScoreSubmissionClient(ss.config).QueryScore(ss)
+}
+
+// Update returns a builder for updating this ScoreSubmission.
+// Note that you need to call ScoreSubmission.Unwrap() before calling this method if this ScoreSubmission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *ScoreSubmission) Update() *ScoreSubmissionUpdateOne {
+	return NewScoreSubmissionClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the ScoreSubmission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *ScoreSubmission) Unwrap() *ScoreSubmission {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScoreSubmission is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *ScoreSubmission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScoreSubmission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("value=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Value))
+	builder.WriteString(", ")
+	builder.WriteString("accepted=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Accepted))
+	builder.WriteString(", ")
+	builder.WriteString("submitted_at=")
+	builder.WriteString(ss.SubmittedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("client_ip=")
+	builder.WriteString(ss.ClientIP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(ss.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScoreSubmissions is a parsable slice of ScoreSubmission.
+type ScoreSubmissions []*ScoreSubmission
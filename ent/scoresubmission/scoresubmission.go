@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scoresubmission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the scoresubmission type in the database.
+	Label = "score_submission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldAccepted holds the string denoting the accepted field in the database.
+	FieldAccepted = "accepted"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldClientIP holds the string denoting the client_ip field in the database.
+	FieldClientIP = "client_ip"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeGame holds the string denoting the game edge name in mutations.
+	EdgeGame = "game"
+	// EdgeServerKey holds the string denoting the server_key edge name in mutations.
+	EdgeServerKey = "server_key"
+	// EdgeScore holds the string denoting the score edge name in mutations.
+	EdgeScore = "score"
+	// Table holds the table name of the scoresubmission in the database.
+	Table = "score_submissions"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "score_submissions"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_submissions"
+	// GameTable is the table that holds the game relation/edge.
+	GameTable = "score_submissions"
+	// GameInverseTable is the table name for the Game entity.
+	// It exists in this package in order to avoid circular dependency with the "game" package.
+	GameInverseTable = "games"
+	// GameColumn is the table column denoting the game relation/edge.
+	GameColumn = "game_submissions"
+	// ServerKeyTable is the table that holds the server_key relation/edge.
+	ServerKeyTable = "score_submissions"
+	// ServerKeyInverseTable is the table name for the ServerKey entity.
+	// It exists in this package in order to avoid circular dependency with the "serverkey" package.
+	ServerKeyInverseTable = "server_keys"
+	// ServerKeyColumn is the table column denoting the server_key relation/edge.
+	ServerKeyColumn = "server_key_submissions"
+	// ScoreTable is the table that holds the score relation/edge.
+	ScoreTable = "score_submissions"
+	// ScoreInverseTable is the table name for the Score entity.
+	// It exists in this package in order to avoid circular dependency with the "score" package.
+	ScoreInverseTable = "scores"
+	// ScoreColumn is the table column denoting the score relation/edge.
+	ScoreColumn = "score_submissions"
+)
+
+// Columns holds all SQL columns for scoresubmission fields.
+var Columns = []string{
+	FieldID,
+	FieldValue,
+	FieldAccepted,
+	FieldSubmittedAt,
+	FieldClientIP,
+	FieldUserAgent,
+	FieldMetadata,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "score_submissions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"game_submissions",
+	"score_submissions",
+	"server_key_submissions",
+	"user_submissions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(int64) error
+	// DefaultAccepted holds the default value on creation for the "accepted" field.
+	DefaultAccepted bool
+	// DefaultSubmittedAt holds the default value on creation for the "submitted_at" field.
+	DefaultSubmittedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ScoreSubmission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByAccepted orders the results by the accepted field.
+func ByAccepted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccepted, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByClientIP orders the results by the client_ip field.
+func ByClientIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientIP, opts...).ToFunc()
+}
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGameField orders the results by game field.
+func ByGameField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGameStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByServerKeyField orders the results by server_key field.
+func ByServerKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newServerKeyStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByScoreField orders the results by score field.
+func ByScoreField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScoreStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newGameStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GameInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
+	)
+}
+func newServerKeyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ServerKeyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ServerKeyTable, ServerKeyColumn),
+	)
+}
+func newScoreStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScoreInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ScoreTable, ScoreColumn),
+	)
+}
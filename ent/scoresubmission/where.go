@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scoresubmission
+
+import (
+	"game-scores/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLTE(FieldID, id))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldValue, v))
+}
+
+// Accepted applies equality check predicate on the "accepted" field. It's identical to AcceptedEQ.
+func Accepted(v bool) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldAccepted, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// ClientIP applies equality check predicate on the "client_ip" field. It's identical to ClientIPEQ.
+func ClientIP(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldClientIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int64) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLTE(FieldValue, v))
+}
+
+// AcceptedEQ applies the EQ predicate on the "accepted" field.
+func AcceptedEQ(v bool) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldAccepted, v))
+}
+
+// AcceptedNEQ applies the NEQ predicate on the "accepted" field.
+func AcceptedNEQ(v bool) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNEQ(FieldAccepted, v))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// ClientIPEQ applies the EQ predicate on the "client_ip" field.
+func ClientIPEQ(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldClientIP, v))
+}
+
+// ClientIPNEQ applies the NEQ predicate on the "client_ip" field.
+func ClientIPNEQ(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNEQ(FieldClientIP, v))
+}
+
+// ClientIPIn applies the In predicate on the "client_ip" field.
+func ClientIPIn(vs ...string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIn(FieldClientIP, vs...))
+}
+
+// ClientIPNotIn applies the NotIn predicate on the "client_ip" field.
+func ClientIPNotIn(vs ...string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotIn(FieldClientIP, vs...))
+}
+
+// ClientIPGT applies the GT predicate on the "client_ip" field.
+func ClientIPGT(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGT(FieldClientIP, v))
+}
+
+// ClientIPGTE applies the GTE predicate on the "client_ip" field.
+func ClientIPGTE(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGTE(FieldClientIP, v))
+}
+
+// ClientIPLT applies the LT predicate on the "client_ip" field.
+func ClientIPLT(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLT(FieldClientIP, v))
+}
+
+// ClientIPLTE applies the LTE predicate on the "client_ip" field.
+func ClientIPLTE(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLTE(FieldClientIP, v))
+}
+
+// ClientIPContains applies the Contains predicate on the "client_ip" field.
+func ClientIPContains(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldContains(FieldClientIP, v))
+}
+
+// ClientIPHasPrefix applies the HasPrefix predicate on the "client_ip" field.
+func ClientIPHasPrefix(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldHasPrefix(FieldClientIP, v))
+}
+
+// ClientIPHasSuffix applies the HasSuffix predicate on the "client_ip" field.
+func ClientIPHasSuffix(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldHasSuffix(FieldClientIP, v))
+}
+
+// ClientIPIsNil applies the IsNil predicate on the "client_ip" field.
+func ClientIPIsNil() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIsNull(FieldClientIP))
+}
+
+// ClientIPNotNil applies the NotNil predicate on the "client_ip" field.
+func ClientIPNotNil() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotNull(FieldClientIP))
+}
+
+// ClientIPEqualFold applies the EqualFold predicate on the "client_ip" field.
+func ClientIPEqualFold(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEqualFold(FieldClientIP, v))
+}
+
+// ClientIPContainsFold applies the ContainsFold predicate on the "client_ip" field.
+func ClientIPContainsFold(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldContainsFold(FieldClientIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentIsNil applies the IsNil predicate on the "user_agent" field.
+func UserAgentIsNil() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIsNull(FieldUserAgent))
+}
+
+// UserAgentNotNil applies the NotNil predicate on the "user_agent" field.
+func UserAgentNotNil() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotNull(FieldUserAgent))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGame applies the HasEdge predicate on the "game" edge.
+func HasGame() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGameWith applies the HasEdge predicate on the "game" edge with a given conditions (other predicates).
+func HasGameWith(preds ...predicate.Game) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := newGameStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasServerKey applies the HasEdge predicate on the "server_key" edge.
+func HasServerKey() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ServerKeyTable, ServerKeyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasServerKeyWith applies the HasEdge predicate on the "server_key" edge with a given conditions (other predicates).
+func HasServerKeyWith(preds ...predicate.ServerKey) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := newServerKeyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasScore applies the HasEdge predicate on the "score" edge.
+func HasScore() predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ScoreTable, ScoreColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasScoreWith applies the HasEdge predicate on the "score" edge with a given conditions (other predicates).
+func HasScoreWith(preds ...predicate.Score) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(func(s *sql.Selector) {
+		step := newScoreStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScoreSubmission) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScoreSubmission) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScoreSubmission) predicate.ScoreSubmission {
+	return predicate.ScoreSubmission(sql.NotPredicates(p))
+}
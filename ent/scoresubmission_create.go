@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScoreSubmissionCreate is the builder for creating a ScoreSubmission entity.
+type ScoreSubmissionCreate struct {
+	config
+	mutation *ScoreSubmissionMutation
+	hooks    []Hook
+}
+
+// SetValue sets the "value" field.
+func (ssc *ScoreSubmissionCreate) SetValue(i int64) *ScoreSubmissionCreate {
+	ssc.mutation.SetValue(i)
+	return ssc
+}
+
+// SetAccepted sets the "accepted" field.
+func (ssc *ScoreSubmissionCreate) SetAccepted(b bool) *ScoreSubmissionCreate {
+	ssc.mutation.SetAccepted(b)
+	return ssc
+}
+
+// SetNillableAccepted sets the "accepted" field if the given value is not nil.
+func (ssc *ScoreSubmissionCreate) SetNillableAccepted(b *bool) *ScoreSubmissionCreate {
+	if b != nil {
+		ssc.SetAccepted(*b)
+	}
+	return ssc
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (ssc *ScoreSubmissionCreate) SetSubmittedAt(t time.Time) *ScoreSubmissionCreate {
+	ssc.mutation.SetSubmittedAt(t)
+	return ssc
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (ssc *ScoreSubmissionCreate) SetNillableSubmittedAt(t *time.Time) *ScoreSubmissionCreate {
+	if t != nil {
+		ssc.SetSubmittedAt(*t)
+	}
+	return ssc
+}
+
+// SetClientIP sets the "client_ip" field.
+func (ssc *ScoreSubmissionCreate) SetClientIP(s string) *ScoreSubmissionCreate {
+	ssc.mutation.SetClientIP(s)
+	return ssc
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (ssc *ScoreSubmissionCreate) SetNillableClientIP(s *string) *ScoreSubmissionCreate {
+	if s != nil {
+		ssc.SetClientIP(*s)
+	}
+	return ssc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (ssc *ScoreSubmissionCreate) SetUserAgent(s string) *ScoreSubmissionCreate {
+	ssc.mutation.SetUserAgent(s)
+	return ssc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (ssc *ScoreSubmissionCreate) SetNillableUserAgent(s *string) *ScoreSubmissionCreate {
+	if s != nil {
+		ssc.SetUserAgent(*s)
+	}
+	return ssc
+}
+
+// SetMetadata sets the "metadata" field.
+func (ssc *ScoreSubmissionCreate) SetMetadata(m map[string]string) *ScoreSubmissionCreate {
+	ssc.mutation.SetMetadata(m)
+	return ssc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ssc *ScoreSubmissionCreate) SetUserID(id uuid.UUID) *ScoreSubmissionCreate {
+	ssc.mutation.SetUserID(id)
+	return ssc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ssc *ScoreSubmissionCreate) SetUser(u *User) *ScoreSubmissionCreate {
+	return ssc.SetUserID(u.ID)
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (ssc *ScoreSubmissionCreate) SetGameID(id int) *ScoreSubmissionCreate {
+	ssc.mutation.SetGameID(id)
+	return ssc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (ssc *ScoreSubmissionCreate) SetGame(g *Game) *ScoreSubmissionCreate {
+	return ssc.SetGameID(g.ID)
+}
+
+// SetServerKeyID sets the "server_key" edge to the ServerKey entity by ID.
+func (ssc *ScoreSubmissionCreate) SetServerKeyID(id int) *ScoreSubmissionCreate {
+	ssc.mutation.SetServerKeyID(id)
+	return ssc
+}
+
+// SetNillableServerKeyID sets the "server_key" edge to the ServerKey entity by ID if the given value is not nil.
+func (ssc *ScoreSubmissionCreate) SetNillableServerKeyID(id *int) *ScoreSubmissionCreate {
+	if id != nil {
+		ssc = ssc.SetServerKeyID(*id)
+	}
+	return ssc
+}
+
+// SetServerKey sets the "server_key" edge to the ServerKey entity.
+func (ssc *ScoreSubmissionCreate) SetServerKey(s *ServerKey) *ScoreSubmissionCreate {
+	return ssc.SetServerKeyID(s.ID)
+}
+
+// SetScoreID sets the "score" edge to the Score entity by ID.
+func (ssc *ScoreSubmissionCreate) SetScoreID(id int) *ScoreSubmissionCreate {
+	ssc.mutation.SetScoreID(id)
+	return ssc
+}
+
+// SetNillableScoreID sets the "score" edge to the Score entity by ID if the given value is not nil.
+func (ssc *ScoreSubmissionCreate) SetNillableScoreID(id *int) *ScoreSubmissionCreate {
+	if id != nil {
+		ssc = ssc.SetScoreID(*id)
+	}
+	return ssc
+}
+
+// SetScore sets the "score" edge to the Score entity.
+func (ssc *ScoreSubmissionCreate) SetScore(s *Score) *ScoreSubmissionCreate {
+	return ssc.SetScoreID(s.ID)
+}
+
+// Mutation returns the ScoreSubmissionMutation object of the builder.
+func (ssc *ScoreSubmissionCreate) Mutation() *ScoreSubmissionMutation {
+	return ssc.mutation
+}
+
+// Save creates the ScoreSubmission in the database.
+func (ssc *ScoreSubmissionCreate) Save(ctx context.Context) (*ScoreSubmission, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *ScoreSubmissionCreate) SaveX(ctx context.Context) *ScoreSubmission {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *ScoreSubmissionCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *ScoreSubmissionCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *ScoreSubmissionCreate) defaults() {
+	if _, ok := ssc.mutation.Accepted(); !ok {
+		v := scoresubmission.DefaultAccepted
+		ssc.mutation.SetAccepted(v)
+	}
+	if _, ok := ssc.mutation.SubmittedAt(); !ok {
+		v := scoresubmission.DefaultSubmittedAt()
+		ssc.mutation.SetSubmittedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *ScoreSubmissionCreate) check() error {
+	if _, ok := ssc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ScoreSubmission.value"`)}
+	}
+	if v, ok := ssc.mutation.Value(); ok {
+		if err := scoresubmission.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "ScoreSubmission.value": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Accepted(); !ok {
+		return &ValidationError{Name: "accepted", err: errors.New(`ent: missing required field "ScoreSubmission.accepted"`)}
+	}
+	if _, ok := ssc.mutation.SubmittedAt(); !ok {
+		return &ValidationError{Name: "submitted_at", err: errors.New(`ent: missing required field "ScoreSubmission.submitted_at"`)}
+	}
+	if len(ssc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ScoreSubmission.user"`)}
+	}
+	if len(ssc.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "ScoreSubmission.game"`)}
+	}
+	return nil
+}
+
+func (ssc *ScoreSubmissionCreate) sqlSave(ctx context.Context) (*ScoreSubmission, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *ScoreSubmissionCreate) createSpec() (*ScoreSubmission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScoreSubmission{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(scoresubmission.Table, sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt))
+	)
+	if value, ok := ssc.mutation.Value(); ok {
+		_spec.SetField(scoresubmission.FieldValue, field.TypeInt64, value)
+		_node.Value = value
+	}
+	if value, ok := ssc.mutation.Accepted(); ok {
+		_spec.SetField(scoresubmission.FieldAccepted, field.TypeBool, value)
+		_node.Accepted = value
+	}
+	if value, ok := ssc.mutation.SubmittedAt(); ok {
+		_spec.SetField(scoresubmission.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = value
+	}
+	if value, ok := ssc.mutation.ClientIP(); ok {
+		_spec.SetField(scoresubmission.FieldClientIP, field.TypeString, value)
+		_node.ClientIP = value
+	}
+	if value, ok := ssc.mutation.UserAgent(); ok {
+		_spec.SetField(scoresubmission.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := ssc.mutation.Metadata(); ok {
+		_spec.SetField(scoresubmission.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := ssc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.UserTable,
+			Columns: []string{scoresubmission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_submissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ssc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.GameTable,
+			Columns: []string{scoresubmission.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_submissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ssc.mutation.ServerKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ServerKeyTable,
+			Columns: []string{scoresubmission.ServerKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.server_key_submissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ssc.mutation.ScoreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ScoreTable,
+			Columns: []string{scoresubmission.ScoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.score_submissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScoreSubmissionCreateBulk is the builder for creating many ScoreSubmission entities in bulk.
+type ScoreSubmissionCreateBulk struct {
+	config
+	err      error
+	builders []*ScoreSubmissionCreate
+}
+
+// Save creates the ScoreSubmission entities in the database.
+func (sscb *ScoreSubmissionCreateBulk) Save(ctx context.Context) ([]*ScoreSubmission, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*ScoreSubmission, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScoreSubmissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *ScoreSubmissionCreateBulk) SaveX(ctx context.Context) []*ScoreSubmission {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *ScoreSubmissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *ScoreSubmissionCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"game-scores/ent/predicate"
+	"game-scores/ent/scoresubmission"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ScoreSubmissionDelete is the builder for deleting a ScoreSubmission entity.
+type ScoreSubmissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScoreSubmissionMutation
+}
+
+// Where appends a list predicates to the ScoreSubmissionDelete builder.
+func (ssd *ScoreSubmissionDelete) Where(ps ...predicate.ScoreSubmission) *ScoreSubmissionDelete {
+	ssd.mutation.Where(ps...)
+	return ssd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ssd *ScoreSubmissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssd.sqlExec, ssd.mutation, ssd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssd *ScoreSubmissionDelete) ExecX(ctx context.Context) int {
+	n, err := ssd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ssd *ScoreSubmissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scoresubmission.Table, sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt))
+	if ps := ssd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ssd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ssd.mutation.done = true
+	return affected, err
+}
+
+// ScoreSubmissionDeleteOne is the builder for deleting a single ScoreSubmission entity.
+type ScoreSubmissionDeleteOne struct {
+	ssd *ScoreSubmissionDelete
+}
+
+// Where appends a list predicates to the ScoreSubmissionDelete builder.
+func (ssdo *ScoreSubmissionDeleteOne) Where(ps ...predicate.ScoreSubmission) *ScoreSubmissionDeleteOne {
+	ssdo.ssd.mutation.Where(ps...)
+	return ssdo
+}
+
+// Exec executes the deletion query.
+func (ssdo *ScoreSubmissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := ssdo.ssd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scoresubmission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssdo *ScoreSubmissionDeleteOne) ExecX(ctx context.Context) {
+	if err := ssdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
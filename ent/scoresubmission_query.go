@@ -0,0 +1,863 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScoreSubmissionQuery is the builder for querying ScoreSubmission entities.
+type ScoreSubmissionQuery struct {
+	config
+	ctx           *QueryContext
+	order         []scoresubmission.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.ScoreSubmission
+	withUser      *UserQuery
+	withGame      *GameQuery
+	withServerKey *ServerKeyQuery
+	withScore     *ScoreQuery
+	withFKs       bool
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScoreSubmissionQuery builder.
+func (ssq *ScoreSubmissionQuery) Where(ps ...predicate.ScoreSubmission) *ScoreSubmissionQuery {
+	ssq.predicates = append(ssq.predicates, ps...)
+	return ssq
+}
+
+// Limit the number of records to be returned by this query.
+func (ssq *ScoreSubmissionQuery) Limit(limit int) *ScoreSubmissionQuery {
+	ssq.ctx.Limit = &limit
+	return ssq
+}
+
+// Offset to start from.
+func (ssq *ScoreSubmissionQuery) Offset(offset int) *ScoreSubmissionQuery {
+	ssq.ctx.Offset = &offset
+	return ssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssq *ScoreSubmissionQuery) Unique(unique bool) *ScoreSubmissionQuery {
+	ssq.ctx.Unique = &unique
+	return ssq
+}
+
+// Order specifies how the records should be ordered.
+func (ssq *ScoreSubmissionQuery) Order(o ...scoresubmission.OrderOption) *ScoreSubmissionQuery {
+	ssq.order = append(ssq.order, o...)
+	return ssq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ssq *ScoreSubmissionQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scoresubmission.Table, scoresubmission.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scoresubmission.UserTable, scoresubmission.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGame chains the current query on the "game" edge.
+func (ssq *ScoreSubmissionQuery) QueryGame() *GameQuery {
+	query := (&GameClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scoresubmission.Table, scoresubmission.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scoresubmission.GameTable, scoresubmission.GameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryServerKey chains the current query on the "server_key" edge.
+func (ssq *ScoreSubmissionQuery) QueryServerKey() *ServerKeyQuery {
+	query := (&ServerKeyClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scoresubmission.Table, scoresubmission.FieldID, selector),
+			sqlgraph.To(serverkey.Table, serverkey.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scoresubmission.ServerKeyTable, scoresubmission.ServerKeyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryScore chains the current query on the "score" edge.
+func (ssq *ScoreSubmissionQuery) QueryScore() *ScoreQuery {
+	query := (&ScoreClient{config: ssq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scoresubmission.Table, scoresubmission.FieldID, selector),
+			sqlgraph.To(score.Table, score.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scoresubmission.ScoreTable, scoresubmission.ScoreColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScoreSubmission entity from the query.
+// Returns a *NotFoundError when no ScoreSubmission was found.
+func (ssq *ScoreSubmissionQuery) First(ctx context.Context) (*ScoreSubmission, error) {
+	nodes, err := ssq.Limit(1).All(setContextOp(ctx, ssq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scoresubmission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) FirstX(ctx context.Context) *ScoreSubmission {
+	node, err := ssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScoreSubmission ID from the query.
+// Returns a *NotFoundError when no ScoreSubmission ID was found.
+func (ssq *ScoreSubmissionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ssq.Limit(1).IDs(setContextOp(ctx, ssq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scoresubmission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) FirstIDX(ctx context.Context) int {
+	id, err := ssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScoreSubmission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScoreSubmission entity is found.
+// Returns a *NotFoundError when no ScoreSubmission entities are found.
+func (ssq *ScoreSubmissionQuery) Only(ctx context.Context) (*ScoreSubmission, error) {
+	nodes, err := ssq.Limit(2).All(setContextOp(ctx, ssq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scoresubmission.Label}
+	default:
+		return nil, &NotSingularError{scoresubmission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) OnlyX(ctx context.Context) *ScoreSubmission {
+	node, err := ssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScoreSubmission ID in the query.
+// Returns a *NotSingularError when more than one ScoreSubmission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssq *ScoreSubmissionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ssq.Limit(2).IDs(setContextOp(ctx, ssq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scoresubmission.Label}
+	default:
+		err = &NotSingularError{scoresubmission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScoreSubmissions.
+func (ssq *ScoreSubmissionQuery) All(ctx context.Context) ([]*ScoreSubmission, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryAll)
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScoreSubmission, *ScoreSubmissionQuery]()
+	return withInterceptors[[]*ScoreSubmission](ctx, ssq, qr, ssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) AllX(ctx context.Context) []*ScoreSubmission {
+	nodes, err := ssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScoreSubmission IDs.
+func (ssq *ScoreSubmissionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ssq.ctx.Unique == nil && ssq.path != nil {
+		ssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryIDs)
+	if err = ssq.Select(scoresubmission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) IDsX(ctx context.Context) []int {
+	ids, err := ssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssq *ScoreSubmissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryCount)
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ssq, querierCount[*ScoreSubmissionQuery](), ssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) CountX(ctx context.Context) int {
+	count, err := ssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssq *ScoreSubmissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ssq.ctx, ent.OpQueryExist)
+	switch _, err := ssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssq *ScoreSubmissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScoreSubmissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssq *ScoreSubmissionQuery) Clone() *ScoreSubmissionQuery {
+	if ssq == nil {
+		return nil
+	}
+	return &ScoreSubmissionQuery{
+		config:        ssq.config,
+		ctx:           ssq.ctx.Clone(),
+		order:         append([]scoresubmission.OrderOption{}, ssq.order...),
+		inters:        append([]Interceptor{}, ssq.inters...),
+		predicates:    append([]predicate.ScoreSubmission{}, ssq.predicates...),
+		withUser:      ssq.withUser.Clone(),
+		withGame:      ssq.withGame.Clone(),
+		withServerKey: ssq.withServerKey.Clone(),
+		withScore:     ssq.withScore.Clone(),
+		// clone intermediate query.
+		sql:       ssq.sql.Clone(),
+		path:      ssq.path,
+		modifiers: append([]func(*sql.Selector){}, ssq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *ScoreSubmissionQuery) WithUser(opts ...func(*UserQuery)) *ScoreSubmissionQuery {
+	query := (&UserClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withUser = query
+	return ssq
+}
+
+// WithGame tells the query-builder to eager-load the nodes that are connected to
+// the "game" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *ScoreSubmissionQuery) WithGame(opts ...func(*GameQuery)) *ScoreSubmissionQuery {
+	query := (&GameClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withGame = query
+	return ssq
+}
+
+// WithServerKey tells the query-builder to eager-load the nodes that are connected to
+// the "server_key" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *ScoreSubmissionQuery) WithServerKey(opts ...func(*ServerKeyQuery)) *ScoreSubmissionQuery {
+	query := (&ServerKeyClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withServerKey = query
+	return ssq
+}
+
+// WithScore tells the query-builder to eager-load the nodes that are connected to
+// the "score" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *ScoreSubmissionQuery) WithScore(opts ...func(*ScoreQuery)) *ScoreSubmissionQuery {
+	query := (&ScoreClient{config: ssq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withScore = query
+	return ssq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Value int64 `json:"value,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScoreSubmission.Query().
+//		GroupBy(scoresubmission.FieldValue).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ssq *ScoreSubmissionQuery) GroupBy(field string, fields ...string) *ScoreSubmissionGroupBy {
+	ssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScoreSubmissionGroupBy{build: ssq}
+	grbuild.flds = &ssq.ctx.Fields
+	grbuild.label = scoresubmission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Value int64 `json:"value,omitempty"`
+//	}
+//
+//	client.ScoreSubmission.Query().
+//		Select(scoresubmission.FieldValue).
+//		Scan(ctx, &v)
+func (ssq *ScoreSubmissionQuery) Select(fields ...string) *ScoreSubmissionSelect {
+	ssq.ctx.Fields = append(ssq.ctx.Fields, fields...)
+	sbuild := &ScoreSubmissionSelect{ScoreSubmissionQuery: ssq}
+	sbuild.label = scoresubmission.Label
+	sbuild.flds, sbuild.scan = &ssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScoreSubmissionSelect configured with the given aggregations.
+func (ssq *ScoreSubmissionQuery) Aggregate(fns ...AggregateFunc) *ScoreSubmissionSelect {
+	return ssq.Select().Aggregate(fns...)
+}
+
+func (ssq *ScoreSubmissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ssq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ssq.ctx.Fields {
+		if !scoresubmission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ssq.path != nil {
+		prev, err := ssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssq.sql = prev
+	}
+	return nil
+}
+
+func (ssq *ScoreSubmissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScoreSubmission, error) {
+	var (
+		nodes       = []*ScoreSubmission{}
+		withFKs     = ssq.withFKs
+		_spec       = ssq.querySpec()
+		loadedTypes = [4]bool{
+			ssq.withUser != nil,
+			ssq.withGame != nil,
+			ssq.withServerKey != nil,
+			ssq.withScore != nil,
+		}
+	)
+	if ssq.withUser != nil || ssq.withGame != nil || ssq.withServerKey != nil || ssq.withScore != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, scoresubmission.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScoreSubmission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScoreSubmission{config: ssq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ssq.withUser; query != nil {
+		if err := ssq.loadUser(ctx, query, nodes, nil,
+			func(n *ScoreSubmission, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ssq.withGame; query != nil {
+		if err := ssq.loadGame(ctx, query, nodes, nil,
+			func(n *ScoreSubmission, e *Game) { n.Edges.Game = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ssq.withServerKey; query != nil {
+		if err := ssq.loadServerKey(ctx, query, nodes, nil,
+			func(n *ScoreSubmission, e *ServerKey) { n.Edges.ServerKey = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ssq.withScore; query != nil {
+		if err := ssq.loadScore(ctx, query, nodes, nil,
+			func(n *ScoreSubmission, e *Score) { n.Edges.Score = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ssq *ScoreSubmissionQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*ScoreSubmission, init func(*ScoreSubmission), assign func(*ScoreSubmission, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScoreSubmission)
+	for i := range nodes {
+		if nodes[i].user_submissions == nil {
+			continue
+		}
+		fk := *nodes[i].user_submissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_submissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ssq *ScoreSubmissionQuery) loadGame(ctx context.Context, query *GameQuery, nodes []*ScoreSubmission, init func(*ScoreSubmission), assign func(*ScoreSubmission, *Game)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ScoreSubmission)
+	for i := range nodes {
+		if nodes[i].game_submissions == nil {
+			continue
+		}
+		fk := *nodes[i].game_submissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(game.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "game_submissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ssq *ScoreSubmissionQuery) loadServerKey(ctx context.Context, query *ServerKeyQuery, nodes []*ScoreSubmission, init func(*ScoreSubmission), assign func(*ScoreSubmission, *ServerKey)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ScoreSubmission)
+	for i := range nodes {
+		if nodes[i].server_key_submissions == nil {
+			continue
+		}
+		fk := *nodes[i].server_key_submissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(serverkey.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "server_key_submissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ssq *ScoreSubmissionQuery) loadScore(ctx context.Context, query *ScoreQuery, nodes []*ScoreSubmission, init func(*ScoreSubmission), assign func(*ScoreSubmission, *Score)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ScoreSubmission)
+	for i := range nodes {
+		if nodes[i].score_submissions == nil {
+			continue
+		}
+		fk := *nodes[i].score_submissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(score.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "score_submissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ssq *ScoreSubmissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssq.querySpec()
+	if len(ssq.modifiers) > 0 {
+		_spec.Modifiers = ssq.modifiers
+	}
+	_spec.Node.Columns = ssq.ctx.Fields
+	if len(ssq.ctx.Fields) > 0 {
+		_spec.Unique = ssq.ctx.Unique != nil && *ssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ssq.driver, _spec)
+}
+
+func (ssq *ScoreSubmissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scoresubmission.Table, scoresubmission.Columns, sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt))
+	_spec.From = ssq.sql
+	if unique := ssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scoresubmission.FieldID)
+		for i := range fields {
+			if fields[i] != scoresubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssq *ScoreSubmissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssq.driver.Dialect())
+	t1 := builder.Table(scoresubmission.Table)
+	columns := ssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scoresubmission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssq.sql != nil {
+		selector = ssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssq.ctx.Unique != nil && *ssq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ssq.modifiers {
+		m(selector)
+	}
+	for _, p := range ssq.predicates {
+		p(selector)
+	}
+	for _, p := range ssq.order {
+		p(selector)
+	}
+	if offset := ssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ssq *ScoreSubmissionQuery) Modify(modifiers ...func(s *sql.Selector)) *ScoreSubmissionSelect {
+	ssq.modifiers = append(ssq.modifiers, modifiers...)
+	return ssq.Select()
+}
+
+// ScoreSubmissionGroupBy is the group-by builder for ScoreSubmission entities.
+type ScoreSubmissionGroupBy struct {
+	selector
+	build *ScoreSubmissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssgb *ScoreSubmissionGroupBy) Aggregate(fns ...AggregateFunc) *ScoreSubmissionGroupBy {
+	ssgb.fns = append(ssgb.fns, fns...)
+	return ssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssgb *ScoreSubmissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScoreSubmissionQuery, *ScoreSubmissionGroupBy](ctx, ssgb.build, ssgb, ssgb.build.inters, v)
+}
+
+func (ssgb *ScoreSubmissionGroupBy) sqlScan(ctx context.Context, root *ScoreSubmissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ssgb.fns))
+	for _, fn := range ssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ssgb.flds)+len(ssgb.fns))
+		for _, f := range *ssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScoreSubmissionSelect is the builder for selecting fields of ScoreSubmission entities.
+type ScoreSubmissionSelect struct {
+	*ScoreSubmissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sss *ScoreSubmissionSelect) Aggregate(fns ...AggregateFunc) *ScoreSubmissionSelect {
+	sss.fns = append(sss.fns, fns...)
+	return sss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sss *ScoreSubmissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sss.ctx, ent.OpQuerySelect)
+	if err := sss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScoreSubmissionQuery, *ScoreSubmissionSelect](ctx, sss.ScoreSubmissionQuery, sss, sss.inters, v)
+}
+
+func (sss *ScoreSubmissionSelect) sqlScan(ctx context.Context, root *ScoreSubmissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sss.fns))
+	for _, fn := range sss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sss *ScoreSubmissionSelect) Modify(modifiers ...func(s *sql.Selector)) *ScoreSubmissionSelect {
+	sss.modifiers = append(sss.modifiers, modifiers...)
+	return sss
+}
@@ -0,0 +1,798 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"game-scores/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScoreSubmissionUpdate is the builder for updating ScoreSubmission entities.
+type ScoreSubmissionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ScoreSubmissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ScoreSubmissionUpdate builder.
+func (ssu *ScoreSubmissionUpdate) Where(ps ...predicate.ScoreSubmission) *ScoreSubmissionUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetAccepted sets the "accepted" field.
+func (ssu *ScoreSubmissionUpdate) SetAccepted(b bool) *ScoreSubmissionUpdate {
+	ssu.mutation.SetAccepted(b)
+	return ssu
+}
+
+// SetNillableAccepted sets the "accepted" field if the given value is not nil.
+func (ssu *ScoreSubmissionUpdate) SetNillableAccepted(b *bool) *ScoreSubmissionUpdate {
+	if b != nil {
+		ssu.SetAccepted(*b)
+	}
+	return ssu
+}
+
+// SetClientIP sets the "client_ip" field.
+func (ssu *ScoreSubmissionUpdate) SetClientIP(s string) *ScoreSubmissionUpdate {
+	ssu.mutation.SetClientIP(s)
+	return ssu
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (ssu *ScoreSubmissionUpdate) SetNillableClientIP(s *string) *ScoreSubmissionUpdate {
+	if s != nil {
+		ssu.SetClientIP(*s)
+	}
+	return ssu
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (ssu *ScoreSubmissionUpdate) ClearClientIP() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearClientIP()
+	return ssu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (ssu *ScoreSubmissionUpdate) SetUserAgent(s string) *ScoreSubmissionUpdate {
+	ssu.mutation.SetUserAgent(s)
+	return ssu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (ssu *ScoreSubmissionUpdate) SetNillableUserAgent(s *string) *ScoreSubmissionUpdate {
+	if s != nil {
+		ssu.SetUserAgent(*s)
+	}
+	return ssu
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (ssu *ScoreSubmissionUpdate) ClearUserAgent() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearUserAgent()
+	return ssu
+}
+
+// SetMetadata sets the "metadata" field.
+func (ssu *ScoreSubmissionUpdate) SetMetadata(m map[string]string) *ScoreSubmissionUpdate {
+	ssu.mutation.SetMetadata(m)
+	return ssu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ssu *ScoreSubmissionUpdate) ClearMetadata() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearMetadata()
+	return ssu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ssu *ScoreSubmissionUpdate) SetUserID(id uuid.UUID) *ScoreSubmissionUpdate {
+	ssu.mutation.SetUserID(id)
+	return ssu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ssu *ScoreSubmissionUpdate) SetUser(u *User) *ScoreSubmissionUpdate {
+	return ssu.SetUserID(u.ID)
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (ssu *ScoreSubmissionUpdate) SetGameID(id int) *ScoreSubmissionUpdate {
+	ssu.mutation.SetGameID(id)
+	return ssu
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (ssu *ScoreSubmissionUpdate) SetGame(g *Game) *ScoreSubmissionUpdate {
+	return ssu.SetGameID(g.ID)
+}
+
+// SetServerKeyID sets the "server_key" edge to the ServerKey entity by ID.
+func (ssu *ScoreSubmissionUpdate) SetServerKeyID(id int) *ScoreSubmissionUpdate {
+	ssu.mutation.SetServerKeyID(id)
+	return ssu
+}
+
+// SetNillableServerKeyID sets the "server_key" edge to the ServerKey entity by ID if the given value is not nil.
+func (ssu *ScoreSubmissionUpdate) SetNillableServerKeyID(id *int) *ScoreSubmissionUpdate {
+	if id != nil {
+		ssu = ssu.SetServerKeyID(*id)
+	}
+	return ssu
+}
+
+// SetServerKey sets the "server_key" edge to the ServerKey entity.
+func (ssu *ScoreSubmissionUpdate) SetServerKey(s *ServerKey) *ScoreSubmissionUpdate {
+	return ssu.SetServerKeyID(s.ID)
+}
+
+// SetScoreID sets the "score" edge to the Score entity by ID.
+func (ssu *ScoreSubmissionUpdate) SetScoreID(id int) *ScoreSubmissionUpdate {
+	ssu.mutation.SetScoreID(id)
+	return ssu
+}
+
+// SetNillableScoreID sets the "score" edge to the Score entity by ID if the given value is not nil.
+func (ssu *ScoreSubmissionUpdate) SetNillableScoreID(id *int) *ScoreSubmissionUpdate {
+	if id != nil {
+		ssu = ssu.SetScoreID(*id)
+	}
+	return ssu
+}
+
+// SetScore sets the "score" edge to the Score entity.
+func (ssu *ScoreSubmissionUpdate) SetScore(s *Score) *ScoreSubmissionUpdate {
+	return ssu.SetScoreID(s.ID)
+}
+
+// Mutation returns the ScoreSubmissionMutation object of the builder.
+func (ssu *ScoreSubmissionUpdate) Mutation() *ScoreSubmissionMutation {
+	return ssu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ssu *ScoreSubmissionUpdate) ClearUser() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearUser()
+	return ssu
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (ssu *ScoreSubmissionUpdate) ClearGame() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearGame()
+	return ssu
+}
+
+// ClearServerKey clears the "server_key" edge to the ServerKey entity.
+func (ssu *ScoreSubmissionUpdate) ClearServerKey() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearServerKey()
+	return ssu
+}
+
+// ClearScore clears the "score" edge to the Score entity.
+func (ssu *ScoreSubmissionUpdate) ClearScore() *ScoreSubmissionUpdate {
+	ssu.mutation.ClearScore()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *ScoreSubmissionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *ScoreSubmissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *ScoreSubmissionUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *ScoreSubmissionUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *ScoreSubmissionUpdate) check() error {
+	if ssu.mutation.UserCleared() && len(ssu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScoreSubmission.user"`)
+	}
+	if ssu.mutation.GameCleared() && len(ssu.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScoreSubmission.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ssu *ScoreSubmissionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScoreSubmissionUpdate {
+	ssu.modifiers = append(ssu.modifiers, modifiers...)
+	return ssu
+}
+
+func (ssu *ScoreSubmissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scoresubmission.Table, scoresubmission.Columns, sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.Accepted(); ok {
+		_spec.SetField(scoresubmission.FieldAccepted, field.TypeBool, value)
+	}
+	if value, ok := ssu.mutation.ClientIP(); ok {
+		_spec.SetField(scoresubmission.FieldClientIP, field.TypeString, value)
+	}
+	if ssu.mutation.ClientIPCleared() {
+		_spec.ClearField(scoresubmission.FieldClientIP, field.TypeString)
+	}
+	if value, ok := ssu.mutation.UserAgent(); ok {
+		_spec.SetField(scoresubmission.FieldUserAgent, field.TypeString, value)
+	}
+	if ssu.mutation.UserAgentCleared() {
+		_spec.ClearField(scoresubmission.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := ssu.mutation.Metadata(); ok {
+		_spec.SetField(scoresubmission.FieldMetadata, field.TypeJSON, value)
+	}
+	if ssu.mutation.MetadataCleared() {
+		_spec.ClearField(scoresubmission.FieldMetadata, field.TypeJSON)
+	}
+	if ssu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.UserTable,
+			Columns: []string{scoresubmission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.UserTable,
+			Columns: []string{scoresubmission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssu.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.GameTable,
+			Columns: []string{scoresubmission.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.GameTable,
+			Columns: []string{scoresubmission.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssu.mutation.ServerKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ServerKeyTable,
+			Columns: []string{scoresubmission.ServerKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.ServerKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ServerKeyTable,
+			Columns: []string{scoresubmission.ServerKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssu.mutation.ScoreCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ScoreTable,
+			Columns: []string{scoresubmission.ScoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.ScoreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ScoreTable,
+			Columns: []string{scoresubmission.ScoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ssu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scoresubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// ScoreSubmissionUpdateOne is the builder for updating a single ScoreSubmission entity.
+type ScoreSubmissionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ScoreSubmissionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAccepted sets the "accepted" field.
+func (ssuo *ScoreSubmissionUpdateOne) SetAccepted(b bool) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetAccepted(b)
+	return ssuo
+}
+
+// SetNillableAccepted sets the "accepted" field if the given value is not nil.
+func (ssuo *ScoreSubmissionUpdateOne) SetNillableAccepted(b *bool) *ScoreSubmissionUpdateOne {
+	if b != nil {
+		ssuo.SetAccepted(*b)
+	}
+	return ssuo
+}
+
+// SetClientIP sets the "client_ip" field.
+func (ssuo *ScoreSubmissionUpdateOne) SetClientIP(s string) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetClientIP(s)
+	return ssuo
+}
+
+// SetNillableClientIP sets the "client_ip" field if the given value is not nil.
+func (ssuo *ScoreSubmissionUpdateOne) SetNillableClientIP(s *string) *ScoreSubmissionUpdateOne {
+	if s != nil {
+		ssuo.SetClientIP(*s)
+	}
+	return ssuo
+}
+
+// ClearClientIP clears the value of the "client_ip" field.
+func (ssuo *ScoreSubmissionUpdateOne) ClearClientIP() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearClientIP()
+	return ssuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (ssuo *ScoreSubmissionUpdateOne) SetUserAgent(s string) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetUserAgent(s)
+	return ssuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (ssuo *ScoreSubmissionUpdateOne) SetNillableUserAgent(s *string) *ScoreSubmissionUpdateOne {
+	if s != nil {
+		ssuo.SetUserAgent(*s)
+	}
+	return ssuo
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (ssuo *ScoreSubmissionUpdateOne) ClearUserAgent() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearUserAgent()
+	return ssuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (ssuo *ScoreSubmissionUpdateOne) SetMetadata(m map[string]string) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetMetadata(m)
+	return ssuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (ssuo *ScoreSubmissionUpdateOne) ClearMetadata() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearMetadata()
+	return ssuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (ssuo *ScoreSubmissionUpdateOne) SetUserID(id uuid.UUID) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetUserID(id)
+	return ssuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ssuo *ScoreSubmissionUpdateOne) SetUser(u *User) *ScoreSubmissionUpdateOne {
+	return ssuo.SetUserID(u.ID)
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (ssuo *ScoreSubmissionUpdateOne) SetGameID(id int) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetGameID(id)
+	return ssuo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (ssuo *ScoreSubmissionUpdateOne) SetGame(g *Game) *ScoreSubmissionUpdateOne {
+	return ssuo.SetGameID(g.ID)
+}
+
+// SetServerKeyID sets the "server_key" edge to the ServerKey entity by ID.
+func (ssuo *ScoreSubmissionUpdateOne) SetServerKeyID(id int) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetServerKeyID(id)
+	return ssuo
+}
+
+// SetNillableServerKeyID sets the "server_key" edge to the ServerKey entity by ID if the given value is not nil.
+func (ssuo *ScoreSubmissionUpdateOne) SetNillableServerKeyID(id *int) *ScoreSubmissionUpdateOne {
+	if id != nil {
+		ssuo = ssuo.SetServerKeyID(*id)
+	}
+	return ssuo
+}
+
+// SetServerKey sets the "server_key" edge to the ServerKey entity.
+func (ssuo *ScoreSubmissionUpdateOne) SetServerKey(s *ServerKey) *ScoreSubmissionUpdateOne {
+	return ssuo.SetServerKeyID(s.ID)
+}
+
+// SetScoreID sets the "score" edge to the Score entity by ID.
+func (ssuo *ScoreSubmissionUpdateOne) SetScoreID(id int) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.SetScoreID(id)
+	return ssuo
+}
+
+// SetNillableScoreID sets the "score" edge to the Score entity by ID if the given value is not nil.
+func (ssuo *ScoreSubmissionUpdateOne) SetNillableScoreID(id *int) *ScoreSubmissionUpdateOne {
+	if id != nil {
+		ssuo = ssuo.SetScoreID(*id)
+	}
+	return ssuo
+}
+
+// SetScore sets the "score" edge to the Score entity.
+func (ssuo *ScoreSubmissionUpdateOne) SetScore(s *Score) *ScoreSubmissionUpdateOne {
+	return ssuo.SetScoreID(s.ID)
+}
+
+// Mutation returns the ScoreSubmissionMutation object of the builder.
+func (ssuo *ScoreSubmissionUpdateOne) Mutation() *ScoreSubmissionMutation {
+	return ssuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (ssuo *ScoreSubmissionUpdateOne) ClearUser() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearUser()
+	return ssuo
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (ssuo *ScoreSubmissionUpdateOne) ClearGame() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearGame()
+	return ssuo
+}
+
+// ClearServerKey clears the "server_key" edge to the ServerKey entity.
+func (ssuo *ScoreSubmissionUpdateOne) ClearServerKey() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearServerKey()
+	return ssuo
+}
+
+// ClearScore clears the "score" edge to the Score entity.
+func (ssuo *ScoreSubmissionUpdateOne) ClearScore() *ScoreSubmissionUpdateOne {
+	ssuo.mutation.ClearScore()
+	return ssuo
+}
+
+// Where appends a list predicates to the ScoreSubmissionUpdate builder.
+func (ssuo *ScoreSubmissionUpdateOne) Where(ps ...predicate.ScoreSubmission) *ScoreSubmissionUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *ScoreSubmissionUpdateOne) Select(field string, fields ...string) *ScoreSubmissionUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated ScoreSubmission entity.
+func (ssuo *ScoreSubmissionUpdateOne) Save(ctx context.Context) (*ScoreSubmission, error) {
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *ScoreSubmissionUpdateOne) SaveX(ctx context.Context) *ScoreSubmission {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *ScoreSubmissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *ScoreSubmissionUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *ScoreSubmissionUpdateOne) check() error {
+	if ssuo.mutation.UserCleared() && len(ssuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScoreSubmission.user"`)
+	}
+	if ssuo.mutation.GameCleared() && len(ssuo.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScoreSubmission.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (ssuo *ScoreSubmissionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ScoreSubmissionUpdateOne {
+	ssuo.modifiers = append(ssuo.modifiers, modifiers...)
+	return ssuo
+}
+
+func (ssuo *ScoreSubmissionUpdateOne) sqlSave(ctx context.Context) (_node *ScoreSubmission, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scoresubmission.Table, scoresubmission.Columns, sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScoreSubmission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scoresubmission.FieldID)
+		for _, f := range fields {
+			if !scoresubmission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scoresubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.Accepted(); ok {
+		_spec.SetField(scoresubmission.FieldAccepted, field.TypeBool, value)
+	}
+	if value, ok := ssuo.mutation.ClientIP(); ok {
+		_spec.SetField(scoresubmission.FieldClientIP, field.TypeString, value)
+	}
+	if ssuo.mutation.ClientIPCleared() {
+		_spec.ClearField(scoresubmission.FieldClientIP, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.UserAgent(); ok {
+		_spec.SetField(scoresubmission.FieldUserAgent, field.TypeString, value)
+	}
+	if ssuo.mutation.UserAgentCleared() {
+		_spec.ClearField(scoresubmission.FieldUserAgent, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.Metadata(); ok {
+		_spec.SetField(scoresubmission.FieldMetadata, field.TypeJSON, value)
+	}
+	if ssuo.mutation.MetadataCleared() {
+		_spec.ClearField(scoresubmission.FieldMetadata, field.TypeJSON)
+	}
+	if ssuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.UserTable,
+			Columns: []string{scoresubmission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.UserTable,
+			Columns: []string{scoresubmission.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssuo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.GameTable,
+			Columns: []string{scoresubmission.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.GameTable,
+			Columns: []string{scoresubmission.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssuo.mutation.ServerKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ServerKeyTable,
+			Columns: []string{scoresubmission.ServerKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.ServerKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ServerKeyTable,
+			Columns: []string{scoresubmission.ServerKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ssuo.mutation.ScoreCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ScoreTable,
+			Columns: []string{scoresubmission.ScoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.ScoreIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scoresubmission.ScoreTable,
+			Columns: []string{scoresubmission.ScoreColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(ssuo.modifiers...)
+	_node = &ScoreSubmission{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scoresubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
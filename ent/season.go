@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/season"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Season is the model entity for the Season schema.
+type Season struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt time.Time `json:"starts_at,omitempty"`
+	// EndsAt holds the value of the "ends_at" field.
+	EndsAt time.Time `json:"ends_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SeasonQuery when eager-loading is set.
+	Edges        SeasonEdges `json:"edges"`
+	game_seasons *int
+	selectValues sql.SelectValues
+}
+
+// SeasonEdges holds the relations/edges for other nodes in the graph.
+type SeasonEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// Scores holds the value of the scores edge.
+	Scores []*Score `json:"scores,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SeasonEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// ScoresOrErr returns the Scores value or an error if the edge
+// was not loaded in eager-loading.
+func (e SeasonEdges) ScoresOrErr() ([]*Score, error) {
+	if e.loadedTypes[1] {
+		return e.Scores, nil
+	}
+	return nil, &NotLoadedError{edge: "scores"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Season) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case season.FieldID:
+			values[i] = new(sql.NullInt64)
+		case season.FieldName:
+			values[i] = new(sql.NullString)
+		case season.FieldStartsAt, season.FieldEndsAt:
+			values[i] = new(sql.NullTime)
+		case season.ForeignKeys[0]: // game_seasons
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Season fields.
+func (s *Season) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case season.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case season.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case season.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				s.StartsAt = value.Time
+			}
+		case season.FieldEndsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ends_at", values[i])
+			} else if value.Valid {
+				s.EndsAt = value.Time
+			}
+		case season.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field game_seasons", value)
+			} else if value.Valid {
+				s.game_seasons = new(int)
+				*s.game_seasons = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Season.
+// This includes values selected through modifiers, order, etc.
+func (s *Season) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the Season entity.
+func (s *Season) QueryGame() *GameQuery {
+	return NewSeasonClient(s.config).QueryGame(s)
+}
+
+// QueryScores queries the "scores" edge of the Season entity.
+func (s *Season) QueryScores() *ScoreQuery {
+	return NewSeasonClient(s.config).QueryScores(s)
+}
+
+// Update returns a builder for updating this Season.
+// Note that you need to call Season.Unwrap() before calling this method if this Season
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Season) Update() *SeasonUpdateOne {
+	return NewSeasonClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Season entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Season) Unwrap() *Season {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Season is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Season) String() string {
+	var builder strings.Builder
+	builder.WriteString("Season(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("starts_at=")
+	builder.WriteString(s.StartsAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("ends_at=")
+	builder.WriteString(s.EndsAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Seasons is a parsable slice of Season.
+type Seasons []*Season
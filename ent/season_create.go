@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/score"
+	"game-scores/ent/season"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeasonCreate is the builder for creating a Season entity.
+type SeasonCreate struct {
+	config
+	mutation *SeasonMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sc *SeasonCreate) SetName(s string) *SeasonCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (sc *SeasonCreate) SetStartsAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetStartsAt(t)
+	return sc
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (sc *SeasonCreate) SetEndsAt(t time.Time) *SeasonCreate {
+	sc.mutation.SetEndsAt(t)
+	return sc
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (sc *SeasonCreate) SetGameID(id int) *SeasonCreate {
+	sc.mutation.SetGameID(id)
+	return sc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (sc *SeasonCreate) SetGame(g *Game) *SeasonCreate {
+	return sc.SetGameID(g.ID)
+}
+
+// AddScoreIDs adds the "scores" edge to the Score entity by IDs.
+func (sc *SeasonCreate) AddScoreIDs(ids ...int) *SeasonCreate {
+	sc.mutation.AddScoreIDs(ids...)
+	return sc
+}
+
+// AddScores adds the "scores" edges to the Score entity.
+func (sc *SeasonCreate) AddScores(s ...*Score) *SeasonCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddScoreIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (sc *SeasonCreate) Mutation() *SeasonMutation {
+	return sc.mutation
+}
+
+// Save creates the Season in the database.
+func (sc *SeasonCreate) Save(ctx context.Context) (*Season, error) {
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SeasonCreate) SaveX(ctx context.Context) *Season {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SeasonCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SeasonCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SeasonCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Season.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := season.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Season.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StartsAt(); !ok {
+		return &ValidationError{Name: "starts_at", err: errors.New(`ent: missing required field "Season.starts_at"`)}
+	}
+	if _, ok := sc.mutation.EndsAt(); !ok {
+		return &ValidationError{Name: "ends_at", err: errors.New(`ent: missing required field "Season.ends_at"`)}
+	}
+	if len(sc.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "Season.game"`)}
+	}
+	return nil
+}
+
+func (sc *SeasonCreate) sqlSave(ctx context.Context) (*Season, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SeasonCreate) createSpec() (*Season, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Season{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(season.Table, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(season.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.StartsAt(); ok {
+		_spec.SetField(season.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = value
+	}
+	if value, ok := sc.mutation.EndsAt(); ok {
+		_spec.SetField(season.FieldEndsAt, field.TypeTime, value)
+		_node.EndsAt = value
+	}
+	if nodes := sc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.GameTable,
+			Columns: []string{season.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_seasons = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.ScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SeasonCreateBulk is the builder for creating many Season entities in bulk.
+type SeasonCreateBulk struct {
+	config
+	err      error
+	builders []*SeasonCreate
+}
+
+// Save creates the Season entities in the database.
+func (scb *SeasonCreateBulk) Save(ctx context.Context) ([]*Season, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Season, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SeasonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SeasonCreateBulk) SaveX(ctx context.Context) []*Season {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SeasonCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SeasonCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
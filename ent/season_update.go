@@ -0,0 +1,582 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/score"
+	"game-scores/ent/season"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SeasonUpdate is the builder for updating Season entities.
+type SeasonUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SeasonMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SeasonUpdate builder.
+func (su *SeasonUpdate) Where(ps ...predicate.Season) *SeasonUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *SeasonUpdate) SetName(s string) *SeasonUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableName(s *string) *SeasonUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (su *SeasonUpdate) SetStartsAt(t time.Time) *SeasonUpdate {
+	su.mutation.SetStartsAt(t)
+	return su
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableStartsAt(t *time.Time) *SeasonUpdate {
+	if t != nil {
+		su.SetStartsAt(*t)
+	}
+	return su
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (su *SeasonUpdate) SetEndsAt(t time.Time) *SeasonUpdate {
+	su.mutation.SetEndsAt(t)
+	return su
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (su *SeasonUpdate) SetNillableEndsAt(t *time.Time) *SeasonUpdate {
+	if t != nil {
+		su.SetEndsAt(*t)
+	}
+	return su
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (su *SeasonUpdate) SetGameID(id int) *SeasonUpdate {
+	su.mutation.SetGameID(id)
+	return su
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (su *SeasonUpdate) SetGame(g *Game) *SeasonUpdate {
+	return su.SetGameID(g.ID)
+}
+
+// AddScoreIDs adds the "scores" edge to the Score entity by IDs.
+func (su *SeasonUpdate) AddScoreIDs(ids ...int) *SeasonUpdate {
+	su.mutation.AddScoreIDs(ids...)
+	return su
+}
+
+// AddScores adds the "scores" edges to the Score entity.
+func (su *SeasonUpdate) AddScores(s ...*Score) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddScoreIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (su *SeasonUpdate) Mutation() *SeasonMutation {
+	return su.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (su *SeasonUpdate) ClearGame() *SeasonUpdate {
+	su.mutation.ClearGame()
+	return su
+}
+
+// ClearScores clears all "scores" edges to the Score entity.
+func (su *SeasonUpdate) ClearScores() *SeasonUpdate {
+	su.mutation.ClearScores()
+	return su
+}
+
+// RemoveScoreIDs removes the "scores" edge to Score entities by IDs.
+func (su *SeasonUpdate) RemoveScoreIDs(ids ...int) *SeasonUpdate {
+	su.mutation.RemoveScoreIDs(ids...)
+	return su
+}
+
+// RemoveScores removes "scores" edges to Score entities.
+func (su *SeasonUpdate) RemoveScores(s ...*Score) *SeasonUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveScoreIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *SeasonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *SeasonUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *SeasonUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *SeasonUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *SeasonUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := season.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Season.name": %w`, err)}
+		}
+	}
+	if su.mutation.GameCleared() && len(su.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Season.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (su *SeasonUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SeasonUpdate {
+	su.modifiers = append(su.modifiers, modifiers...)
+	return su
+}
+
+func (su *SeasonUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(season.Table, season.Columns, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(season.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.StartsAt(); ok {
+		_spec.SetField(season.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.EndsAt(); ok {
+		_spec.SetField(season.FieldEndsAt, field.TypeTime, value)
+	}
+	if su.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.GameTable,
+			Columns: []string{season.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.GameTable,
+			Columns: []string{season.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.ScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedScoresIDs(); len(nodes) > 0 && !su.mutation.ScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.ScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(su.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{season.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// SeasonUpdateOne is the builder for updating a single Season entity.
+type SeasonUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SeasonMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (suo *SeasonUpdateOne) SetName(s string) *SeasonUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableName(s *string) *SeasonUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (suo *SeasonUpdateOne) SetStartsAt(t time.Time) *SeasonUpdateOne {
+	suo.mutation.SetStartsAt(t)
+	return suo
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableStartsAt(t *time.Time) *SeasonUpdateOne {
+	if t != nil {
+		suo.SetStartsAt(*t)
+	}
+	return suo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (suo *SeasonUpdateOne) SetEndsAt(t time.Time) *SeasonUpdateOne {
+	suo.mutation.SetEndsAt(t)
+	return suo
+}
+
+// SetNillableEndsAt sets the "ends_at" field if the given value is not nil.
+func (suo *SeasonUpdateOne) SetNillableEndsAt(t *time.Time) *SeasonUpdateOne {
+	if t != nil {
+		suo.SetEndsAt(*t)
+	}
+	return suo
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (suo *SeasonUpdateOne) SetGameID(id int) *SeasonUpdateOne {
+	suo.mutation.SetGameID(id)
+	return suo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (suo *SeasonUpdateOne) SetGame(g *Game) *SeasonUpdateOne {
+	return suo.SetGameID(g.ID)
+}
+
+// AddScoreIDs adds the "scores" edge to the Score entity by IDs.
+func (suo *SeasonUpdateOne) AddScoreIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.AddScoreIDs(ids...)
+	return suo
+}
+
+// AddScores adds the "scores" edges to the Score entity.
+func (suo *SeasonUpdateOne) AddScores(s ...*Score) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddScoreIDs(ids...)
+}
+
+// Mutation returns the SeasonMutation object of the builder.
+func (suo *SeasonUpdateOne) Mutation() *SeasonMutation {
+	return suo.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (suo *SeasonUpdateOne) ClearGame() *SeasonUpdateOne {
+	suo.mutation.ClearGame()
+	return suo
+}
+
+// ClearScores clears all "scores" edges to the Score entity.
+func (suo *SeasonUpdateOne) ClearScores() *SeasonUpdateOne {
+	suo.mutation.ClearScores()
+	return suo
+}
+
+// RemoveScoreIDs removes the "scores" edge to Score entities by IDs.
+func (suo *SeasonUpdateOne) RemoveScoreIDs(ids ...int) *SeasonUpdateOne {
+	suo.mutation.RemoveScoreIDs(ids...)
+	return suo
+}
+
+// RemoveScores removes "scores" edges to Score entities.
+func (suo *SeasonUpdateOne) RemoveScores(s ...*Score) *SeasonUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveScoreIDs(ids...)
+}
+
+// Where appends a list predicates to the SeasonUpdate builder.
+func (suo *SeasonUpdateOne) Where(ps ...predicate.Season) *SeasonUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *SeasonUpdateOne) Select(field string, fields ...string) *SeasonUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Season entity.
+func (suo *SeasonUpdateOne) Save(ctx context.Context) (*Season, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *SeasonUpdateOne) SaveX(ctx context.Context) *Season {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *SeasonUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *SeasonUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *SeasonUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := season.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Season.name": %w`, err)}
+		}
+	}
+	if suo.mutation.GameCleared() && len(suo.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Season.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (suo *SeasonUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SeasonUpdateOne {
+	suo.modifiers = append(suo.modifiers, modifiers...)
+	return suo
+}
+
+func (suo *SeasonUpdateOne) sqlSave(ctx context.Context) (_node *Season, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(season.Table, season.Columns, sqlgraph.NewFieldSpec(season.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Season.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, season.FieldID)
+		for _, f := range fields {
+			if !season.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != season.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(season.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.StartsAt(); ok {
+		_spec.SetField(season.FieldStartsAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.EndsAt(); ok {
+		_spec.SetField(season.FieldEndsAt, field.TypeTime, value)
+	}
+	if suo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.GameTable,
+			Columns: []string{season.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   season.GameTable,
+			Columns: []string{season.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.ScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedScoresIDs(); len(nodes) > 0 && !suo.mutation.ScoresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.ScoresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   season.ScoresTable,
+			Columns: []string{season.ScoresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(score.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(suo.modifiers...)
+	_node = &Season{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{season.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/serverkey"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ServerKey is the model entity for the ServerKey schema.
+type ServerKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// KeyHash holds the value of the "key_hash" field.
+	KeyHash string `json:"-"`
+	// Prefix holds the value of the "prefix" field.
+	Prefix string `json:"prefix,omitempty"`
+	// Permissions holds the value of the "permissions" field.
+	Permissions []string `json:"permissions,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ServerKeyQuery when eager-loading is set.
+	Edges            ServerKeyEdges `json:"edges"`
+	game_server_keys *int
+	selectValues     sql.SelectValues
+}
+
+// ServerKeyEdges holds the relations/edges for other nodes in the graph.
+type ServerKeyEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// Submissions holds the value of the submissions edge.
+	Submissions []*ScoreSubmission `json:"submissions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ServerKeyEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// SubmissionsOrErr returns the Submissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ServerKeyEdges) SubmissionsOrErr() ([]*ScoreSubmission, error) {
+	if e.loadedTypes[1] {
+		return e.Submissions, nil
+	}
+	return nil, &NotLoadedError{edge: "submissions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ServerKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case serverkey.FieldPermissions:
+			values[i] = new([]byte)
+		case serverkey.FieldID:
+			values[i] = new(sql.NullInt64)
+		case serverkey.FieldName, serverkey.FieldKeyHash, serverkey.FieldPrefix:
+			values[i] = new(sql.NullString)
+		case serverkey.FieldCreatedAt, serverkey.FieldLastUsedAt, serverkey.FieldRevokedAt:
+			values[i] = new(sql.NullTime)
+		case serverkey.ForeignKeys[0]: // game_server_keys
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ServerKey fields.
+func (sk *ServerKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case serverkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sk.ID = int(value.Int64)
+		case serverkey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sk.Name = value.String
+			}
+		case serverkey.FieldKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_hash", values[i])
+			} else if value.Valid {
+				sk.KeyHash = value.String
+			}
+		case serverkey.FieldPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prefix", values[i])
+			} else if value.Valid {
+				sk.Prefix = value.String
+			}
+		case serverkey.FieldPermissions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field permissions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sk.Permissions); err != nil {
+					return fmt.Errorf("unmarshal field permissions: %w", err)
+				}
+			}
+		case serverkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		case serverkey.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				sk.LastUsedAt = new(time.Time)
+				*sk.LastUsedAt = value.Time
+			}
+		case serverkey.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				sk.RevokedAt = new(time.Time)
+				*sk.RevokedAt = value.Time
+			}
+		case serverkey.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field game_server_keys", value)
+			} else if value.Valid {
+				sk.game_server_keys = new(int)
+				*sk.game_server_keys = int(value.Int64)
+			}
+		default:
+			sk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ServerKey.
+// This includes values selected through modifiers, order, etc.
+func (sk *ServerKey) Value(name string) (ent.Value, error) {
+	return sk.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the ServerKey entity.
+func (sk *ServerKey) QueryGame() *GameQuery {
+	return NewServerKeyClient(sk.config).QueryGame(sk)
+}
+
+// QuerySubmissions queries the "submissions" edge of the ServerKey entity.
+func (sk *ServerKey) QuerySubmissions() *ScoreSubmissionQuery {
+	return NewServerKeyClient(sk.config).QuerySubmissions(sk)
+}
+
+// Update returns a builder for updating this ServerKey.
+// Note that you need to call ServerKey.Unwrap() before calling this method if this ServerKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *ServerKey) Update() *ServerKeyUpdateOne {
+	return NewServerKeyClient(sk.config).UpdateOne(sk)
+}
+
+// Unwrap unwraps the ServerKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *ServerKey) Unwrap() *ServerKey {
+	_tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ServerKey is not a transactional entity")
+	}
+	sk.config.driver = _tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *ServerKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("ServerKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sk.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sk.Name)
+	builder.WriteString(", ")
+	builder.WriteString("key_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("prefix=")
+	builder.WriteString(sk.Prefix)
+	builder.WriteString(", ")
+	builder.WriteString("permissions=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Permissions))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sk.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sk.RevokedAt; v != nil {
+		builder.WriteString("revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ServerKeys is a parsable slice of ServerKey.
+type ServerKeys []*ServerKey
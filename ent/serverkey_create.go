@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ServerKeyCreate is the builder for creating a ServerKey entity.
+type ServerKeyCreate struct {
+	config
+	mutation *ServerKeyMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (skc *ServerKeyCreate) SetName(s string) *ServerKeyCreate {
+	skc.mutation.SetName(s)
+	return skc
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (skc *ServerKeyCreate) SetKeyHash(s string) *ServerKeyCreate {
+	skc.mutation.SetKeyHash(s)
+	return skc
+}
+
+// SetPrefix sets the "prefix" field.
+func (skc *ServerKeyCreate) SetPrefix(s string) *ServerKeyCreate {
+	skc.mutation.SetPrefix(s)
+	return skc
+}
+
+// SetPermissions sets the "permissions" field.
+func (skc *ServerKeyCreate) SetPermissions(s []string) *ServerKeyCreate {
+	skc.mutation.SetPermissions(s)
+	return skc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *ServerKeyCreate) SetCreatedAt(t time.Time) *ServerKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *ServerKeyCreate) SetNillableCreatedAt(t *time.Time) *ServerKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (skc *ServerKeyCreate) SetLastUsedAt(t time.Time) *ServerKeyCreate {
+	skc.mutation.SetLastUsedAt(t)
+	return skc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (skc *ServerKeyCreate) SetNillableLastUsedAt(t *time.Time) *ServerKeyCreate {
+	if t != nil {
+		skc.SetLastUsedAt(*t)
+	}
+	return skc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (skc *ServerKeyCreate) SetRevokedAt(t time.Time) *ServerKeyCreate {
+	skc.mutation.SetRevokedAt(t)
+	return skc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (skc *ServerKeyCreate) SetNillableRevokedAt(t *time.Time) *ServerKeyCreate {
+	if t != nil {
+		skc.SetRevokedAt(*t)
+	}
+	return skc
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (skc *ServerKeyCreate) SetGameID(id int) *ServerKeyCreate {
+	skc.mutation.SetGameID(id)
+	return skc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (skc *ServerKeyCreate) SetGame(g *Game) *ServerKeyCreate {
+	return skc.SetGameID(g.ID)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (skc *ServerKeyCreate) AddSubmissionIDs(ids ...int) *ServerKeyCreate {
+	skc.mutation.AddSubmissionIDs(ids...)
+	return skc
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (skc *ServerKeyCreate) AddSubmissions(s ...*ScoreSubmission) *ServerKeyCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return skc.AddSubmissionIDs(ids...)
+}
+
+// Mutation returns the ServerKeyMutation object of the builder.
+func (skc *ServerKeyCreate) Mutation() *ServerKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the ServerKey in the database.
+func (skc *ServerKeyCreate) Save(ctx context.Context) (*ServerKey, error) {
+	skc.defaults()
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *ServerKeyCreate) SaveX(ctx context.Context) *ServerKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *ServerKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *ServerKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *ServerKeyCreate) defaults() {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		v := serverkey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *ServerKeyCreate) check() error {
+	if _, ok := skc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ServerKey.name"`)}
+	}
+	if v, ok := skc.mutation.Name(); ok {
+		if err := serverkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServerKey.name": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "ServerKey.key_hash"`)}
+	}
+	if v, ok := skc.mutation.KeyHash(); ok {
+		if err := serverkey.KeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "key_hash", err: fmt.Errorf(`ent: validator failed for field "ServerKey.key_hash": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "ServerKey.prefix"`)}
+	}
+	if v, ok := skc.mutation.Prefix(); ok {
+		if err := serverkey.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "ServerKey.prefix": %w`, err)}
+		}
+	}
+	if _, ok := skc.mutation.Permissions(); !ok {
+		return &ValidationError{Name: "permissions", err: errors.New(`ent: missing required field "ServerKey.permissions"`)}
+	}
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ServerKey.created_at"`)}
+	}
+	if len(skc.mutation.GameIDs()) == 0 {
+		return &ValidationError{Name: "game", err: errors.New(`ent: missing required edge "ServerKey.game"`)}
+	}
+	return nil
+}
+
+func (skc *ServerKeyCreate) sqlSave(ctx context.Context) (*ServerKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *ServerKeyCreate) createSpec() (*ServerKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ServerKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(serverkey.Table, sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt))
+	)
+	if value, ok := skc.mutation.Name(); ok {
+		_spec.SetField(serverkey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := skc.mutation.KeyHash(); ok {
+		_spec.SetField(serverkey.FieldKeyHash, field.TypeString, value)
+		_node.KeyHash = value
+	}
+	if value, ok := skc.mutation.Prefix(); ok {
+		_spec.SetField(serverkey.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := skc.mutation.Permissions(); ok {
+		_spec.SetField(serverkey.FieldPermissions, field.TypeJSON, value)
+		_node.Permissions = value
+	}
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(serverkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := skc.mutation.LastUsedAt(); ok {
+		_spec.SetField(serverkey.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := skc.mutation.RevokedAt(); ok {
+		_spec.SetField(serverkey.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if nodes := skc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serverkey.GameTable,
+			Columns: []string{serverkey.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_server_keys = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := skc.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ServerKeyCreateBulk is the builder for creating many ServerKey entities in bulk.
+type ServerKeyCreateBulk struct {
+	config
+	err      error
+	builders []*ServerKeyCreate
+}
+
+// Save creates the ServerKey entities in the database.
+func (skcb *ServerKeyCreateBulk) Save(ctx context.Context) ([]*ServerKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*ServerKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ServerKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *ServerKeyCreateBulk) SaveX(ctx context.Context) []*ServerKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *ServerKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *ServerKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
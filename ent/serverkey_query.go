@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ServerKeyQuery is the builder for querying ServerKey entities.
+type ServerKeyQuery struct {
+	config
+	ctx             *QueryContext
+	order           []serverkey.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ServerKey
+	withGame        *GameQuery
+	withSubmissions *ScoreSubmissionQuery
+	withFKs         bool
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ServerKeyQuery builder.
+func (skq *ServerKeyQuery) Where(ps ...predicate.ServerKey) *ServerKeyQuery {
+	skq.predicates = append(skq.predicates, ps...)
+	return skq
+}
+
+// Limit the number of records to be returned by this query.
+func (skq *ServerKeyQuery) Limit(limit int) *ServerKeyQuery {
+	skq.ctx.Limit = &limit
+	return skq
+}
+
+// Offset to start from.
+func (skq *ServerKeyQuery) Offset(offset int) *ServerKeyQuery {
+	skq.ctx.Offset = &offset
+	return skq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (skq *ServerKeyQuery) Unique(unique bool) *ServerKeyQuery {
+	skq.ctx.Unique = &unique
+	return skq
+}
+
+// Order specifies how the records should be ordered.
+func (skq *ServerKeyQuery) Order(o ...serverkey.OrderOption) *ServerKeyQuery {
+	skq.order = append(skq.order, o...)
+	return skq
+}
+
+// QueryGame chains the current query on the "game" edge.
+func (skq *ServerKeyQuery) QueryGame() *GameQuery {
+	query := (&GameClient{config: skq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serverkey.Table, serverkey.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, serverkey.GameTable, serverkey.GameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySubmissions chains the current query on the "submissions" edge.
+func (skq *ServerKeyQuery) QuerySubmissions() *ScoreSubmissionQuery {
+	query := (&ScoreSubmissionClient{config: skq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := skq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := skq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(serverkey.Table, serverkey.FieldID, selector),
+			sqlgraph.To(scoresubmission.Table, scoresubmission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, serverkey.SubmissionsTable, serverkey.SubmissionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(skq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ServerKey entity from the query.
+// Returns a *NotFoundError when no ServerKey was found.
+func (skq *ServerKeyQuery) First(ctx context.Context) (*ServerKey, error) {
+	nodes, err := skq.Limit(1).All(setContextOp(ctx, skq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{serverkey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (skq *ServerKeyQuery) FirstX(ctx context.Context) *ServerKey {
+	node, err := skq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ServerKey ID from the query.
+// Returns a *NotFoundError when no ServerKey ID was found.
+func (skq *ServerKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skq.Limit(1).IDs(setContextOp(ctx, skq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{serverkey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (skq *ServerKeyQuery) FirstIDX(ctx context.Context) int {
+	id, err := skq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ServerKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ServerKey entity is found.
+// Returns a *NotFoundError when no ServerKey entities are found.
+func (skq *ServerKeyQuery) Only(ctx context.Context) (*ServerKey, error) {
+	nodes, err := skq.Limit(2).All(setContextOp(ctx, skq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{serverkey.Label}
+	default:
+		return nil, &NotSingularError{serverkey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (skq *ServerKeyQuery) OnlyX(ctx context.Context) *ServerKey {
+	node, err := skq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ServerKey ID in the query.
+// Returns a *NotSingularError when more than one ServerKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (skq *ServerKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = skq.Limit(2).IDs(setContextOp(ctx, skq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{serverkey.Label}
+	default:
+		err = &NotSingularError{serverkey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (skq *ServerKeyQuery) OnlyIDX(ctx context.Context) int {
+	id, err := skq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ServerKeys.
+func (skq *ServerKeyQuery) All(ctx context.Context) ([]*ServerKey, error) {
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryAll)
+	if err := skq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ServerKey, *ServerKeyQuery]()
+	return withInterceptors[[]*ServerKey](ctx, skq, qr, skq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (skq *ServerKeyQuery) AllX(ctx context.Context) []*ServerKey {
+	nodes, err := skq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ServerKey IDs.
+func (skq *ServerKeyQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if skq.ctx.Unique == nil && skq.path != nil {
+		skq.Unique(true)
+	}
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryIDs)
+	if err = skq.Select(serverkey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (skq *ServerKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := skq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (skq *ServerKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryCount)
+	if err := skq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, skq, querierCount[*ServerKeyQuery](), skq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (skq *ServerKeyQuery) CountX(ctx context.Context) int {
+	count, err := skq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (skq *ServerKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, skq.ctx, ent.OpQueryExist)
+	switch _, err := skq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (skq *ServerKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := skq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ServerKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (skq *ServerKeyQuery) Clone() *ServerKeyQuery {
+	if skq == nil {
+		return nil
+	}
+	return &ServerKeyQuery{
+		config:          skq.config,
+		ctx:             skq.ctx.Clone(),
+		order:           append([]serverkey.OrderOption{}, skq.order...),
+		inters:          append([]Interceptor{}, skq.inters...),
+		predicates:      append([]predicate.ServerKey{}, skq.predicates...),
+		withGame:        skq.withGame.Clone(),
+		withSubmissions: skq.withSubmissions.Clone(),
+		// clone intermediate query.
+		sql:       skq.sql.Clone(),
+		path:      skq.path,
+		modifiers: append([]func(*sql.Selector){}, skq.modifiers...),
+	}
+}
+
+// WithGame tells the query-builder to eager-load the nodes that are connected to
+// the "game" edge. The optional arguments are used to configure the query builder of the edge.
+func (skq *ServerKeyQuery) WithGame(opts ...func(*GameQuery)) *ServerKeyQuery {
+	query := (&GameClient{config: skq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skq.withGame = query
+	return skq
+}
+
+// WithSubmissions tells the query-builder to eager-load the nodes that are connected to
+// the "submissions" edge. The optional arguments are used to configure the query builder of the edge.
+func (skq *ServerKeyQuery) WithSubmissions(opts ...func(*ScoreSubmissionQuery)) *ServerKeyQuery {
+	query := (&ScoreSubmissionClient{config: skq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	skq.withSubmissions = query
+	return skq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ServerKey.Query().
+//		GroupBy(serverkey.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (skq *ServerKeyQuery) GroupBy(field string, fields ...string) *ServerKeyGroupBy {
+	skq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ServerKeyGroupBy{build: skq}
+	grbuild.flds = &skq.ctx.Fields
+	grbuild.label = serverkey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ServerKey.Query().
+//		Select(serverkey.FieldName).
+//		Scan(ctx, &v)
+func (skq *ServerKeyQuery) Select(fields ...string) *ServerKeySelect {
+	skq.ctx.Fields = append(skq.ctx.Fields, fields...)
+	sbuild := &ServerKeySelect{ServerKeyQuery: skq}
+	sbuild.label = serverkey.Label
+	sbuild.flds, sbuild.scan = &skq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ServerKeySelect configured with the given aggregations.
+func (skq *ServerKeyQuery) Aggregate(fns ...AggregateFunc) *ServerKeySelect {
+	return skq.Select().Aggregate(fns...)
+}
+
+func (skq *ServerKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range skq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, skq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range skq.ctx.Fields {
+		if !serverkey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if skq.path != nil {
+		prev, err := skq.path(ctx)
+		if err != nil {
+			return err
+		}
+		skq.sql = prev
+	}
+	return nil
+}
+
+func (skq *ServerKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ServerKey, error) {
+	var (
+		nodes       = []*ServerKey{}
+		withFKs     = skq.withFKs
+		_spec       = skq.querySpec()
+		loadedTypes = [2]bool{
+			skq.withGame != nil,
+			skq.withSubmissions != nil,
+		}
+	)
+	if skq.withGame != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, serverkey.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ServerKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ServerKey{config: skq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(skq.modifiers) > 0 {
+		_spec.Modifiers = skq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, skq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := skq.withGame; query != nil {
+		if err := skq.loadGame(ctx, query, nodes, nil,
+			func(n *ServerKey, e *Game) { n.Edges.Game = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := skq.withSubmissions; query != nil {
+		if err := skq.loadSubmissions(ctx, query, nodes,
+			func(n *ServerKey) { n.Edges.Submissions = []*ScoreSubmission{} },
+			func(n *ServerKey, e *ScoreSubmission) { n.Edges.Submissions = append(n.Edges.Submissions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (skq *ServerKeyQuery) loadGame(ctx context.Context, query *GameQuery, nodes []*ServerKey, init func(*ServerKey), assign func(*ServerKey, *Game)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ServerKey)
+	for i := range nodes {
+		if nodes[i].game_server_keys == nil {
+			continue
+		}
+		fk := *nodes[i].game_server_keys
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(game.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "game_server_keys" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (skq *ServerKeyQuery) loadSubmissions(ctx context.Context, query *ScoreSubmissionQuery, nodes []*ServerKey, init func(*ServerKey), assign func(*ServerKey, *ScoreSubmission)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*ServerKey)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ScoreSubmission(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(serverkey.SubmissionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.server_key_submissions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "server_key_submissions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "server_key_submissions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (skq *ServerKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := skq.querySpec()
+	if len(skq.modifiers) > 0 {
+		_spec.Modifiers = skq.modifiers
+	}
+	_spec.Node.Columns = skq.ctx.Fields
+	if len(skq.ctx.Fields) > 0 {
+		_spec.Unique = skq.ctx.Unique != nil && *skq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, skq.driver, _spec)
+}
+
+func (skq *ServerKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(serverkey.Table, serverkey.Columns, sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt))
+	_spec.From = skq.sql
+	if unique := skq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if skq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := skq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, serverkey.FieldID)
+		for i := range fields {
+			if fields[i] != serverkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := skq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := skq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := skq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := skq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (skq *ServerKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(skq.driver.Dialect())
+	t1 := builder.Table(serverkey.Table)
+	columns := skq.ctx.Fields
+	if len(columns) == 0 {
+		columns = serverkey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if skq.sql != nil {
+		selector = skq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if skq.ctx.Unique != nil && *skq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range skq.modifiers {
+		m(selector)
+	}
+	for _, p := range skq.predicates {
+		p(selector)
+	}
+	for _, p := range skq.order {
+		p(selector)
+	}
+	if offset := skq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := skq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (skq *ServerKeyQuery) Modify(modifiers ...func(s *sql.Selector)) *ServerKeySelect {
+	skq.modifiers = append(skq.modifiers, modifiers...)
+	return skq.Select()
+}
+
+// ServerKeyGroupBy is the group-by builder for ServerKey entities.
+type ServerKeyGroupBy struct {
+	selector
+	build *ServerKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (skgb *ServerKeyGroupBy) Aggregate(fns ...AggregateFunc) *ServerKeyGroupBy {
+	skgb.fns = append(skgb.fns, fns...)
+	return skgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (skgb *ServerKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, skgb.build.ctx, ent.OpQueryGroupBy)
+	if err := skgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ServerKeyQuery, *ServerKeyGroupBy](ctx, skgb.build, skgb, skgb.build.inters, v)
+}
+
+func (skgb *ServerKeyGroupBy) sqlScan(ctx context.Context, root *ServerKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(skgb.fns))
+	for _, fn := range skgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*skgb.flds)+len(skgb.fns))
+		for _, f := range *skgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*skgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := skgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ServerKeySelect is the builder for selecting fields of ServerKey entities.
+type ServerKeySelect struct {
+	*ServerKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sks *ServerKeySelect) Aggregate(fns ...AggregateFunc) *ServerKeySelect {
+	sks.fns = append(sks.fns, fns...)
+	return sks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sks *ServerKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sks.ctx, ent.OpQuerySelect)
+	if err := sks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ServerKeyQuery, *ServerKeySelect](ctx, sks.ServerKeyQuery, sks, sks.inters, v)
+}
+
+func (sks *ServerKeySelect) sqlScan(ctx context.Context, root *ServerKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sks.fns))
+	for _, fn := range sks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sks *ServerKeySelect) Modify(modifiers ...func(s *sql.Selector)) *ServerKeySelect {
+	sks.modifiers = append(sks.modifiers, modifiers...)
+	return sks
+}
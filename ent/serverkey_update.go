@@ -0,0 +1,659 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/serverkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ServerKeyUpdate is the builder for updating ServerKey entities.
+type ServerKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ServerKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ServerKeyUpdate builder.
+func (sku *ServerKeyUpdate) Where(ps ...predicate.ServerKey) *ServerKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetName sets the "name" field.
+func (sku *ServerKeyUpdate) SetName(s string) *ServerKeyUpdate {
+	sku.mutation.SetName(s)
+	return sku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sku *ServerKeyUpdate) SetNillableName(s *string) *ServerKeyUpdate {
+	if s != nil {
+		sku.SetName(*s)
+	}
+	return sku
+}
+
+// SetPermissions sets the "permissions" field.
+func (sku *ServerKeyUpdate) SetPermissions(s []string) *ServerKeyUpdate {
+	sku.mutation.SetPermissions(s)
+	return sku
+}
+
+// AppendPermissions appends s to the "permissions" field.
+func (sku *ServerKeyUpdate) AppendPermissions(s []string) *ServerKeyUpdate {
+	sku.mutation.AppendPermissions(s)
+	return sku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (sku *ServerKeyUpdate) SetLastUsedAt(t time.Time) *ServerKeyUpdate {
+	sku.mutation.SetLastUsedAt(t)
+	return sku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (sku *ServerKeyUpdate) SetNillableLastUsedAt(t *time.Time) *ServerKeyUpdate {
+	if t != nil {
+		sku.SetLastUsedAt(*t)
+	}
+	return sku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (sku *ServerKeyUpdate) ClearLastUsedAt() *ServerKeyUpdate {
+	sku.mutation.ClearLastUsedAt()
+	return sku
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (sku *ServerKeyUpdate) SetRevokedAt(t time.Time) *ServerKeyUpdate {
+	sku.mutation.SetRevokedAt(t)
+	return sku
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (sku *ServerKeyUpdate) SetNillableRevokedAt(t *time.Time) *ServerKeyUpdate {
+	if t != nil {
+		sku.SetRevokedAt(*t)
+	}
+	return sku
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (sku *ServerKeyUpdate) ClearRevokedAt() *ServerKeyUpdate {
+	sku.mutation.ClearRevokedAt()
+	return sku
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (sku *ServerKeyUpdate) SetGameID(id int) *ServerKeyUpdate {
+	sku.mutation.SetGameID(id)
+	return sku
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (sku *ServerKeyUpdate) SetGame(g *Game) *ServerKeyUpdate {
+	return sku.SetGameID(g.ID)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (sku *ServerKeyUpdate) AddSubmissionIDs(ids ...int) *ServerKeyUpdate {
+	sku.mutation.AddSubmissionIDs(ids...)
+	return sku
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (sku *ServerKeyUpdate) AddSubmissions(s ...*ScoreSubmission) *ServerKeyUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sku.AddSubmissionIDs(ids...)
+}
+
+// Mutation returns the ServerKeyMutation object of the builder.
+func (sku *ServerKeyUpdate) Mutation() *ServerKeyMutation {
+	return sku.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (sku *ServerKeyUpdate) ClearGame() *ServerKeyUpdate {
+	sku.mutation.ClearGame()
+	return sku
+}
+
+// ClearSubmissions clears all "submissions" edges to the ScoreSubmission entity.
+func (sku *ServerKeyUpdate) ClearSubmissions() *ServerKeyUpdate {
+	sku.mutation.ClearSubmissions()
+	return sku
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScoreSubmission entities by IDs.
+func (sku *ServerKeyUpdate) RemoveSubmissionIDs(ids ...int) *ServerKeyUpdate {
+	sku.mutation.RemoveSubmissionIDs(ids...)
+	return sku
+}
+
+// RemoveSubmissions removes "submissions" edges to ScoreSubmission entities.
+func (sku *ServerKeyUpdate) RemoveSubmissions(s ...*ScoreSubmission) *ServerKeyUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sku.RemoveSubmissionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *ServerKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *ServerKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *ServerKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *ServerKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sku *ServerKeyUpdate) check() error {
+	if v, ok := sku.mutation.Name(); ok {
+		if err := serverkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServerKey.name": %w`, err)}
+		}
+	}
+	if sku.mutation.GameCleared() && len(sku.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServerKey.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sku *ServerKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ServerKeyUpdate {
+	sku.modifiers = append(sku.modifiers, modifiers...)
+	return sku
+}
+
+func (sku *ServerKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serverkey.Table, serverkey.Columns, sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.Name(); ok {
+		_spec.SetField(serverkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := sku.mutation.Permissions(); ok {
+		_spec.SetField(serverkey.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := sku.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serverkey.FieldPermissions, value)
+		})
+	}
+	if value, ok := sku.mutation.LastUsedAt(); ok {
+		_spec.SetField(serverkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if sku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(serverkey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.RevokedAt(); ok {
+		_spec.SetField(serverkey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if sku.mutation.RevokedAtCleared() {
+		_spec.ClearField(serverkey.FieldRevokedAt, field.TypeTime)
+	}
+	if sku.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serverkey.GameTable,
+			Columns: []string{serverkey.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sku.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serverkey.GameTable,
+			Columns: []string{serverkey.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if sku.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sku.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !sku.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sku.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serverkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// ServerKeyUpdateOne is the builder for updating a single ServerKey entity.
+type ServerKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ServerKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (skuo *ServerKeyUpdateOne) SetName(s string) *ServerKeyUpdateOne {
+	skuo.mutation.SetName(s)
+	return skuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (skuo *ServerKeyUpdateOne) SetNillableName(s *string) *ServerKeyUpdateOne {
+	if s != nil {
+		skuo.SetName(*s)
+	}
+	return skuo
+}
+
+// SetPermissions sets the "permissions" field.
+func (skuo *ServerKeyUpdateOne) SetPermissions(s []string) *ServerKeyUpdateOne {
+	skuo.mutation.SetPermissions(s)
+	return skuo
+}
+
+// AppendPermissions appends s to the "permissions" field.
+func (skuo *ServerKeyUpdateOne) AppendPermissions(s []string) *ServerKeyUpdateOne {
+	skuo.mutation.AppendPermissions(s)
+	return skuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (skuo *ServerKeyUpdateOne) SetLastUsedAt(t time.Time) *ServerKeyUpdateOne {
+	skuo.mutation.SetLastUsedAt(t)
+	return skuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (skuo *ServerKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *ServerKeyUpdateOne {
+	if t != nil {
+		skuo.SetLastUsedAt(*t)
+	}
+	return skuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (skuo *ServerKeyUpdateOne) ClearLastUsedAt() *ServerKeyUpdateOne {
+	skuo.mutation.ClearLastUsedAt()
+	return skuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (skuo *ServerKeyUpdateOne) SetRevokedAt(t time.Time) *ServerKeyUpdateOne {
+	skuo.mutation.SetRevokedAt(t)
+	return skuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (skuo *ServerKeyUpdateOne) SetNillableRevokedAt(t *time.Time) *ServerKeyUpdateOne {
+	if t != nil {
+		skuo.SetRevokedAt(*t)
+	}
+	return skuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (skuo *ServerKeyUpdateOne) ClearRevokedAt() *ServerKeyUpdateOne {
+	skuo.mutation.ClearRevokedAt()
+	return skuo
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (skuo *ServerKeyUpdateOne) SetGameID(id int) *ServerKeyUpdateOne {
+	skuo.mutation.SetGameID(id)
+	return skuo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (skuo *ServerKeyUpdateOne) SetGame(g *Game) *ServerKeyUpdateOne {
+	return skuo.SetGameID(g.ID)
+}
+
+// AddSubmissionIDs adds the "submissions" edge to the ScoreSubmission entity by IDs.
+func (skuo *ServerKeyUpdateOne) AddSubmissionIDs(ids ...int) *ServerKeyUpdateOne {
+	skuo.mutation.AddSubmissionIDs(ids...)
+	return skuo
+}
+
+// AddSubmissions adds the "submissions" edges to the ScoreSubmission entity.
+func (skuo *ServerKeyUpdateOne) AddSubmissions(s ...*ScoreSubmission) *ServerKeyUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return skuo.AddSubmissionIDs(ids...)
+}
+
+// Mutation returns the ServerKeyMutation object of the builder.
+func (skuo *ServerKeyUpdateOne) Mutation() *ServerKeyMutation {
+	return skuo.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (skuo *ServerKeyUpdateOne) ClearGame() *ServerKeyUpdateOne {
+	skuo.mutation.ClearGame()
+	return skuo
+}
+
+// ClearSubmissions clears all "submissions" edges to the ScoreSubmission entity.
+func (skuo *ServerKeyUpdateOne) ClearSubmissions() *ServerKeyUpdateOne {
+	skuo.mutation.ClearSubmissions()
+	return skuo
+}
+
+// RemoveSubmissionIDs removes the "submissions" edge to ScoreSubmission entities by IDs.
+func (skuo *ServerKeyUpdateOne) RemoveSubmissionIDs(ids ...int) *ServerKeyUpdateOne {
+	skuo.mutation.RemoveSubmissionIDs(ids...)
+	return skuo
+}
+
+// RemoveSubmissions removes "submissions" edges to ScoreSubmission entities.
+func (skuo *ServerKeyUpdateOne) RemoveSubmissions(s ...*ScoreSubmission) *ServerKeyUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return skuo.RemoveSubmissionIDs(ids...)
+}
+
+// Where appends a list predicates to the ServerKeyUpdate builder.
+func (skuo *ServerKeyUpdateOne) Where(ps ...predicate.ServerKey) *ServerKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *ServerKeyUpdateOne) Select(field string, fields ...string) *ServerKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated ServerKey entity.
+func (skuo *ServerKeyUpdateOne) Save(ctx context.Context) (*ServerKey, error) {
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *ServerKeyUpdateOne) SaveX(ctx context.Context) *ServerKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *ServerKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *ServerKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skuo *ServerKeyUpdateOne) check() error {
+	if v, ok := skuo.mutation.Name(); ok {
+		if err := serverkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ServerKey.name": %w`, err)}
+		}
+	}
+	if skuo.mutation.GameCleared() && len(skuo.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ServerKey.game"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (skuo *ServerKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ServerKeyUpdateOne {
+	skuo.modifiers = append(skuo.modifiers, modifiers...)
+	return skuo
+}
+
+func (skuo *ServerKeyUpdateOne) sqlSave(ctx context.Context) (_node *ServerKey, err error) {
+	if err := skuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(serverkey.Table, serverkey.Columns, sqlgraph.NewFieldSpec(serverkey.FieldID, field.TypeInt))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ServerKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, serverkey.FieldID)
+		for _, f := range fields {
+			if !serverkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != serverkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.Name(); ok {
+		_spec.SetField(serverkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := skuo.mutation.Permissions(); ok {
+		_spec.SetField(serverkey.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := skuo.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, serverkey.FieldPermissions, value)
+		})
+	}
+	if value, ok := skuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(serverkey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(serverkey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.RevokedAt(); ok {
+		_spec.SetField(serverkey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(serverkey.FieldRevokedAt, field.TypeTime)
+	}
+	if skuo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serverkey.GameTable,
+			Columns: []string{serverkey.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skuo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   serverkey.GameTable,
+			Columns: []string{serverkey.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if skuo.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skuo.mutation.RemovedSubmissionsIDs(); len(nodes) > 0 && !skuo.mutation.SubmissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := skuo.mutation.SubmissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   serverkey.SubmissionsTable,
+			Columns: []string{serverkey.SubmissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scoresubmission.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(skuo.modifiers...)
+	_node = &ServerKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{serverkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
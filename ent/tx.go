@@ -14,8 +14,28 @@ type Tx struct {
 	config
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
+	// PasswordResetToken is the client for interacting with the PasswordResetToken builders.
+	PasswordResetToken *PasswordResetTokenClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// RequestNonce is the client for interacting with the RequestNonce builders.
+	RequestNonce *RequestNonceClient
+	// RevokedToken is the client for interacting with the RevokedToken builders.
+	RevokedToken *RevokedTokenClient
 	// Score is the client for interacting with the Score builders.
 	Score *ScoreClient
+	// ScoreSubmission is the client for interacting with the ScoreSubmission builders.
+	ScoreSubmission *ScoreSubmissionClient
+	// Season is the client for interacting with the Season builders.
+	Season *SeasonClient
+	// ServerKey is the client for interacting with the ServerKey builders.
+	ServerKey *ServerKeyClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -150,7 +170,17 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Game = NewGameClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
+	tx.LoginAttempt = NewLoginAttemptClient(tx.config)
+	tx.PasswordResetToken = NewPasswordResetTokenClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.RequestNonce = NewRequestNonceClient(tx.config)
+	tx.RevokedToken = NewRevokedTokenClient(tx.config)
 	tx.Score = NewScoreClient(tx.config)
+	tx.ScoreSubmission = NewScoreSubmissionClient(tx.config)
+	tx.Season = NewSeasonClient(tx.config)
+	tx.ServerKey = NewServerKeyClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"slices"
+
+	"game-scores/ent"
+)
+
+const (
+	// APIKeyPrefix starts every server key, so leaked keys are easy to spot.
+	APIKeyPrefix = "gsk_"
+	// apiKeyDisplayLength is the length of the start of a key kept to recognize it.
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+)
+
+// NewAPIKey generates a random server key, returning the key handed to the
+// game's operators, the start of the key shown in listings, and the hash to store.
+func NewAPIKey() (string, string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
+	}
+	key := APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hash a server key is stored and looked up by. Keys are
+// random, so a fast hash is enough.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidServerKeyPermission reports whether a permission can be granted to server keys.
+func ValidServerKeyPermission(permission string) bool {
+	return slices.Contains(ServerKeyPermissions, permission)
+}
+
+// ServerKeyAllows reports whether a server key was granted a permission on a game.
+func ServerKeyAllows(key *ent.ServerKey, gameID int, permission string) bool {
+	return key.Edges.Game != nil && key.Edges.Game.ID == gameID && slices.Contains(key.Permissions, permission)
+}
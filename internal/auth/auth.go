@@ -1,6 +1,10 @@
 package auth
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"time"
 
 	"game-scores/ent"
@@ -9,39 +13,90 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// DefaultAccessTokenTTL is how long access tokens are valid when not configured.
+	DefaultAccessTokenTTL = 15 * time.Minute
+	// DefaultRefreshTokenTTL is how long refresh tokens are valid when not configured.
+	DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
 // JWTClaims are custom claims extending default ones.
 type JWTClaims struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Username string    `json:"username"`
-	Role     string    `json:"role"`
+	UserID        uuid.UUID `json:"user_id"`
+	Username      string    `json:"username"`
+	Role          string    `json:"role"`
+	EmailVerified bool      `json:"email_verified"`
+	// TwoFactor is set when the session logged in with a TOTP or recovery code.
+	TwoFactor bool `json:"two_factor,omitempty"`
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT creates a new JWT for a given user.
-func GenerateJWT(user *ent.User, secretKey []byte) (string, error) {
-	// Set the token expiration time (e.g., 24 hours)
-	expirationTime := time.Now().Add(24 * time.Hour)
+// GenerateJWT creates a new JWT for a given user, valid for the given duration,
+// signed with the current key of the key set. twoFactor tells whether the
+// session logged in with a second factor.
+func GenerateJWT(user *ent.User, keys *KeySet, ttl time.Duration, twoFactor bool) (string, error) {
+	// Set the token expiration time
+	expirationTime := time.Now().Add(ttl)
 
 	// Create the JWT claims, including the username and user ID
 	claims := &JWTClaims{
-		UserID:   user.ID,
-		Username: user.Username,
-		Role:     string(user.Role),
+		UserID:        user.ID,
+		Username:      user.Username,
+		Role:          string(user.Role),
+		EmailVerified: user.EmailVerified,
+		TwoFactor:     twoFactor,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(), // Identifies the token, so it can be revoked
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			Issuer:    "game-scores-api",
+			Issuer:    keys.Issuer,
+			Audience:  jwt.ClaimStrings{keys.Audience},
 		},
 	}
 
-	// Create the token with the HS256 algorithm and the claims
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// Sign the token to generate the final token string
+	return keys.sign(claims)
+}
 
-	// Sign the token with our secret key to generate the final token string
-	tokenString, err := token.SignedString(secretKey)
+// ParseJWT verifies a token signed by GenerateJWT and returns its claims. Only
+// tokens signed by a key of the set with its own algorithm, for the set's
+// issuer and audience, are accepted.
+func ParseJWT(tokenString string, keys *KeySet) (*JWTClaims, error) {
+	claims := &JWTClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.verificationKey, keys.parserOptions(keys.Audience)...)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+// NewRefreshToken generates a random refresh token, returning the token handed
+// to the client and the hash to store.
+func NewRefreshToken() (string, string, error) {
+	return newRandomToken()
+}
+
+// HashRefreshToken returns the hash a refresh token is stored and looked up by.
+func HashRefreshToken(token string) string {
+	return hashRandomToken(token)
+}
+
+// newRandomToken generates a random token, returning the token and its hash.
+func newRandomToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
 	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	return token, hashRandomToken(token), nil
+}
 
-	return tokenString, nil
+// hashRandomToken returns the hash a random token is stored and looked up by.
+// The tokens are random, so a fast hash is enough.
+func hashRandomToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,253 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// DefaultIssuer is the issuer of the tokens signed by the API.
+	DefaultIssuer = "game-scores-api"
+	// DefaultAudience is the audience of the tokens signed by the API.
+	DefaultAudience = "game-scores"
+	// MinimumRSAKeyBits is the smallest RSA key accepted for signing tokens.
+	MinimumRSAKeyBits = 2048
+)
+
+// signingKey is a key tokens are signed or verified with, and the only
+// algorithm accepted for it.
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer // Nil for keys that only verify
+	public  crypto.PublicKey
+}
+
+// KeySet holds the keys tokens are signed and verified with. Tokens are signed
+// with the current key, and verified with any key of the set, so keys can be
+// rotated: a new key becomes current while the previous one keeps verifying the
+// tokens it signed until they expire. Keys are identified by the kid header,
+// which is the RFC 7638 thumbprint of the public key.
+type KeySet struct {
+	// Issuer and Audience are set on every token, and required when verifying.
+	Issuer   string
+	Audience string
+
+	current *signingKey
+	keys    map[string]*signingKey
+}
+
+// LoadKeySet reads the keys from PEM files. The first file holds the current
+// signing key, the others hold older keys that are still accepted. Files can
+// hold RSA (RS256) or Ed25519 (EdDSA) keys, either private keys in PKCS #8 or
+// PKCS #1 format, or public keys for verification only.
+func LoadKeySet(paths []string, issuer, audience string) (*KeySet, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+
+	set := &KeySet{Issuer: issuer, Audience: audience, keys: make(map[string]*signingKey)}
+	for i, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parseKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if i == 0 {
+			if key.private == nil {
+				return nil, fmt.Errorf("%s: the current key must be a private key", path)
+			}
+			set.current = key
+		}
+		set.keys[key.kid] = key
+	}
+	return set, nil
+}
+
+// GenerateKeySet returns a key set with a new Ed25519 key. The key only lives in
+// memory, so tokens are no longer valid once the process exits.
+func GenerateKeySet(issuer, audience string) (*KeySet, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	key, err := newSigningKey(private)
+	if err != nil {
+		return nil, err
+	}
+	return &KeySet{
+		Issuer:   issuer,
+		Audience: audience,
+		current:  key,
+		keys:     map[string]*signingKey{key.kid: key},
+	}, nil
+}
+
+// parseKey parses a PEM encoded private or public key.
+func parseKey(data []byte) (*signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newSigningKey(parsed)
+}
+
+// newSigningKey wraps a private or public RSA or Ed25519 key.
+func newSigningKey(k any) (*signingKey, error) {
+	key := &signingKey{}
+	if signer, ok := k.(crypto.Signer); ok {
+		key.private = signer
+		k = signer.Public()
+	}
+
+	switch public := k.(type) {
+	case *rsa.PublicKey:
+		if public.N.BitLen() < MinimumRSAKeyBits {
+			return nil, fmt.Errorf("RSA keys must have at least %d bits", MinimumRSAKeyBits)
+		}
+		key.method = jwt.SigningMethodRS256
+		key.public = public
+	case ed25519.PublicKey:
+		key.method = jwt.SigningMethodEdDSA
+		key.public = public
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, use RSA or Ed25519", k)
+	}
+
+	thumbprint, err := json.Marshal(thumbprintMembers(publicJWK(key)))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(thumbprint)
+	key.kid = base64.RawURLEncoding.EncodeToString(sum[:])
+	return key, nil
+}
+
+// sign signs claims with the current key.
+func (s *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.current.method, claims)
+	token.Header["kid"] = s.current.kid
+	return token.SignedString(s.current.private)
+}
+
+// verificationKey is the jwt.Keyfunc finding the key a token was signed with.
+// Each key only accepts its own algorithm.
+func (s *KeySet) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+	return key.public, nil
+}
+
+// parserOptions pins the accepted algorithms, issuer and audience.
+func (s *KeySet) parserOptions(audience string) []jwt.ParserOption {
+	return []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(s.Issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+}
+
+// JWK is a public key in the JSON Web Key format (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA keys
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519 and EC keys
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"` // EC keys only, the keys of OpenID Connect providers
+}
+
+// JWKS is a JSON Web Key Set, the document published at /.well-known/jwks.json.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public keys of the set, current key first, so other services
+// can verify tokens without holding the private keys.
+func (s *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{publicJWK(s.current)}}
+	for kid, key := range s.keys {
+		if kid != s.current.kid {
+			jwks.Keys = append(jwks.Keys, publicJWK(key))
+		}
+	}
+	return jwks
+}
+
+// publicJWK converts the public part of a key to a JWK.
+func publicJWK(key *signingKey) JWK {
+	jwk := JWK{Kid: key.kid, Use: "sig", Alg: key.method.Alg()}
+	switch public := key.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
+
+// thumbprintMembers returns the required members of a JWK in lexicographic
+// order, as hashed by the RFC 7638 thumbprint.
+func thumbprintMembers(jwk JWK) any {
+	if jwk.Kty == "RSA" {
+		return struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	}
+	return struct {
+		Crv string `json:"crv"`
+		Kty string `json:"kty"`
+		X   string `json:"x"`
+	}{jwk.Crv, jwk.Kty, jwk.X}
+}
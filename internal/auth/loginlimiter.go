@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/loginattempt"
+	"game-scores/ent/predicate"
+)
+
+const (
+	// DefaultLoginFreeAttempts is the number of failed logins of a username
+	// allowed without any delay.
+	DefaultLoginFreeAttempts = 3
+	// DefaultLoginBaseDelay is the delay after the first failure past the free
+	// ones, doubled with each further failure up to DefaultLoginMaxDelay.
+	DefaultLoginBaseDelay = time.Second
+	DefaultLoginMaxDelay  = time.Minute
+	// DefaultLoginLockoutThreshold is the number of failed logins locking out a username.
+	DefaultLoginLockoutThreshold = 10
+	// DefaultLoginIPLockoutThreshold is the number of failed logins, for any
+	// username, locking out a client IP. Zero disables the IP lockout.
+	DefaultLoginIPLockoutThreshold = 50
+	// DefaultLoginLockoutDuration is how long lockouts last, and how long
+	// failures are counted for.
+	DefaultLoginLockoutDuration = 15 * time.Minute
+	// LoginAttemptRetention is how long failed logins are kept for admins to inspect.
+	LoginAttemptRetention = 24 * time.Hour
+)
+
+// LoginLimiter throttles password guessing. Each failed login of a username
+// past the free ones delays the next attempt twice as long as the previous one,
+// and too many failures lock out the username, or the client IP when they are
+// spread over many usernames, e.g. credential stuffing.
+type LoginLimiter struct {
+	db *ent.Client
+
+	FreeAttempts       int
+	BaseDelay          time.Duration
+	MaxDelay           time.Duration
+	LockoutThreshold   int
+	IPLockoutThreshold int
+	LockoutDuration    time.Duration
+}
+
+// NewLoginLimiter returns a login limiter with the default limits.
+func NewLoginLimiter(db *ent.Client) *LoginLimiter {
+	return &LoginLimiter{
+		db:                 db,
+		FreeAttempts:       DefaultLoginFreeAttempts,
+		BaseDelay:          DefaultLoginBaseDelay,
+		MaxDelay:           DefaultLoginMaxDelay,
+		LockoutThreshold:   DefaultLoginLockoutThreshold,
+		IPLockoutThreshold: DefaultLoginIPLockoutThreshold,
+		LockoutDuration:    DefaultLoginLockoutDuration,
+	}
+}
+
+// LoginStatus is the state of the recent failed logins of a username or client IP.
+type LoginStatus struct {
+	// Failures is the number of failed logins counted towards a lockout.
+	Failures int
+	// RetryAt is when the next attempt is allowed, zero when it is allowed now.
+	RetryAt time.Time
+	// Locked is set when the failures reached the lockout threshold.
+	Locked bool
+}
+
+// UsernameStatus returns the state of the recent failed logins of a username.
+func (l *LoginLimiter) UsernameStatus(ctx context.Context, username string) (LoginStatus, error) {
+	return l.usernameStatus(ctx, loginattempt.Username(username))
+}
+
+// IPStatus returns the state of the recent failed logins from a client IP.
+func (l *LoginLimiter) IPStatus(ctx context.Context, ip string) (LoginStatus, error) {
+	return l.ipStatus(ctx, loginattempt.ClientIP(ip))
+}
+
+// usernameStatus returns the state of the recent failed logins matching a
+// predicate, throttled like the ones of a username.
+func (l *LoginLimiter) usernameStatus(ctx context.Context, attempts predicate.LoginAttempt) (LoginStatus, error) {
+	failures, last, err := l.recentFailures(ctx, attempts)
+	if err != nil || failures == 0 {
+		return LoginStatus{}, err
+	}
+
+	status := LoginStatus{Failures: failures}
+	switch {
+	case failures >= l.LockoutThreshold:
+		status.Locked = true
+		status.RetryAt = last.Add(l.LockoutDuration)
+	case failures > l.FreeAttempts:
+		delay := l.BaseDelay << (failures - l.FreeAttempts - 1)
+		if delay > l.MaxDelay || delay <= 0 {
+			delay = l.MaxDelay
+		}
+		status.RetryAt = last.Add(delay)
+	}
+	if !status.RetryAt.After(time.Now()) {
+		status.RetryAt = time.Time{}
+	}
+	return status, nil
+}
+
+// ipStatus returns the state of the recent failed logins matching a predicate,
+// throttled like the ones of a client IP.
+func (l *LoginLimiter) ipStatus(ctx context.Context, attempts predicate.LoginAttempt) (LoginStatus, error) {
+	failures, last, err := l.recentFailures(ctx, attempts)
+	if err != nil || failures == 0 {
+		return LoginStatus{}, err
+	}
+
+	status := LoginStatus{Failures: failures}
+	if l.IPLockoutThreshold > 0 && failures >= l.IPLockoutThreshold {
+		status.Locked = true
+		status.RetryAt = last.Add(l.LockoutDuration)
+	}
+	if !status.RetryAt.After(time.Now()) {
+		status.RetryAt = time.Time{}
+	}
+	return status, nil
+}
+
+// LoginReservation is a login attempt recorded before its credentials are
+// checked, so that concurrent attempts count against each other instead of all
+// passing the throttle before any of them failed. It counts as a failed login
+// until it is released.
+type LoginReservation struct {
+	limiter  *LoginLimiter
+	id       int
+	username string
+	ip       string
+	failed   bool
+}
+
+// Reserve records a login attempt of the username from the client IP, and
+// returns how long the login has to wait because of the other attempts, failed
+// or still being checked. An attempt that has to wait is released already.
+func (l *LoginLimiter) Reserve(ctx context.Context, username, ip string) (*LoginReservation, time.Duration, error) {
+	attempt, err := l.db.LoginAttempt.
+		Create().
+		SetUsername(username).
+		SetClientIP(ip).
+		Save(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	reservation := &LoginReservation{limiter: l, id: attempt.ID, username: username, ip: ip}
+
+	others := loginattempt.IDNEQ(attempt.ID)
+	byUsername, err := l.usernameStatus(ctx, loginattempt.And(loginattempt.Username(username), others))
+	if err != nil {
+		return nil, 0, errors.Join(err, reservation.Release(ctx))
+	}
+	byIP, err := l.ipStatus(ctx, loginattempt.And(loginattempt.ClientIP(ip), others))
+	if err != nil {
+		return nil, 0, errors.Join(err, reservation.Release(ctx))
+	}
+
+	retryAt := byUsername.RetryAt
+	if byIP.RetryAt.After(retryAt) {
+		retryAt = byIP.RetryAt
+	}
+	if retryAt.IsZero() {
+		return reservation, 0, nil
+	}
+	// Refused attempts are not failures, they would extend the delay forever.
+	return nil, time.Until(retryAt), reservation.Release(ctx)
+}
+
+// Fail keeps the attempt as a failed login, and reports whether it locked out
+// the username or the client IP.
+func (r *LoginReservation) Fail(ctx context.Context) (bool, error) {
+	r.failed = true
+
+	byUsername, err := r.limiter.UsernameStatus(ctx, r.username)
+	if err != nil {
+		return false, err
+	}
+	byIP, err := r.limiter.IPStatus(ctx, r.ip)
+	if err != nil {
+		return false, err
+	}
+	return byUsername.Failures == r.limiter.LockoutThreshold || byIP.Failures == r.limiter.IPLockoutThreshold, nil
+}
+
+// Release removes the attempt unless it failed, e.g. once the credentials were
+// right, leaving the other failed logins of the username as they are.
+func (r *LoginReservation) Release(ctx context.Context) error {
+	if r.failed {
+		return nil
+	}
+	_, err := r.limiter.db.LoginAttempt.
+		Delete().
+		Where(loginattempt.ID(r.id)).
+		Exec(ctx)
+	return err
+}
+
+// RecordSuccess clears the failed logins of a username once it logged in,
+// along with its attempts still being checked.
+func (l *LoginLimiter) RecordSuccess(ctx context.Context, username string) error {
+	_, err := l.db.LoginAttempt.
+		Delete().
+		Where(loginattempt.Username(username)).
+		Exec(ctx)
+	return err
+}
+
+// Clear deletes the failed logins matching a predicate, lifting their delays
+// and lockouts, and returns how many were deleted.
+func (l *LoginLimiter) Clear(ctx context.Context, attempts predicate.LoginAttempt) (int, error) {
+	return l.db.LoginAttempt.
+		Delete().
+		Where(attempts).
+		Exec(ctx)
+}
+
+// PurgeExpired deletes the failed logins older than LoginAttemptRetention.
+func (l *LoginLimiter) PurgeExpired(ctx context.Context) (int, error) {
+	return l.db.LoginAttempt.
+		Delete().
+		Where(loginattempt.AttemptedAtLT(time.Now().Add(-LoginAttemptRetention))).
+		Exec(ctx)
+}
+
+// recentFailures returns the number of failed logins matching a predicate in
+// the lockout duration, and when the last one happened.
+func (l *LoginLimiter) recentFailures(ctx context.Context, attempts predicate.LoginAttempt) (int, time.Time, error) {
+	recent := l.db.LoginAttempt.
+		Query().
+		Where(attempts, loginattempt.AttemptedAtGT(time.Now().Add(-l.LockoutDuration)))
+
+	failures, err := recent.Clone().Count(ctx)
+	if err != nil || failures == 0 {
+		return 0, time.Time{}, err
+	}
+	last, err := recent.
+		Order(ent.Desc(loginattempt.FieldAttemptedAt)).
+		First(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return failures, last.AttemptedAt, nil
+}
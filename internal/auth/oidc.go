@@ -0,0 +1,421 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// DefaultOIDCLoginTTL is how long users have to log in at the provider once
+	// they started a federated login.
+	DefaultOIDCLoginTTL = 10 * time.Minute
+	// oidcKeysRefreshInterval limits how often the keys of a provider are fetched
+	// again for an ID token signed with an unknown key, e.g. after a rotation.
+	oidcKeysRefreshInterval = time.Minute
+	// oidcMaxResponseBytes limits the documents read from providers.
+	oidcMaxResponseBytes = 1 << 20
+)
+
+// DefaultOIDCScopes are the scopes requested from providers: the ID token, and
+// the email and profile claims new users are created from.
+var DefaultOIDCScopes = []string{"openid", "email", "profile"}
+
+// OIDCProvider is an OpenID Connect provider users log in with through the
+// authorization code flow with PKCE, e.g. a console network or a Steam bridge.
+// It is configured by its issuer URL only: its endpoints and keys are
+// discovered from the issuer's /.well-known/openid-configuration on first use,
+// so a provider that is down does not keep the API from starting.
+type OIDCProvider struct {
+	// Name identifies the provider in the /auth/{provider} routes and identities.
+	Name   string
+	Issuer string
+	// ClientID and ClientSecret are the credentials of the API at the provider,
+	// which redirects users back to RedirectURL.
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+	// TrustEmail links a new account to the user with the same email, if both
+	// the provider and the user verified it. Only set it for providers whose
+	// email_verified claim can be trusted, or an account of the provider could
+	// take over the user of its email. Otherwise users link accounts once
+	// logged in.
+	TrustEmail bool
+	// Client sends the requests to the provider.
+	Client *http.Client
+
+	mu            sync.Mutex
+	metadata      *oidcMetadata
+	keys          map[string]any
+	keysFetchedAt time.Time
+}
+
+// oidcMetadata is the part of a provider's discovery document the API uses.
+type oidcMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// IDTokenClaims are the claims of an ID token the API reads.
+type IDTokenClaims struct {
+	Nonce             string `json:"nonce"`
+	AuthorizedParty   string `json:"azp,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified,omitempty"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// NewOIDCProvider returns a provider with the default scopes, whose endpoints
+// are discovered from the issuer.
+func NewOIDCProvider(name, issuer, clientID, clientSecret, redirectURL string) *OIDCProvider {
+	return &OIDCProvider{
+		Name:         name,
+		Issuer:       strings.TrimSuffix(issuer, "/"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       DefaultOIDCScopes,
+		Client:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// AuthCodeURL returns the URL of the provider users are redirected to, to log
+// in and consent. The provider redirects them back to RedirectURL with the
+// state, and the nonce ends up in the ID token. The challenge is the PKCE code
+// challenge of the verifier later sent to Exchange.
+func (p *OIDCProvider) AuthCodeURL(ctx context.Context, state, nonce, challenge string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.ClientID},
+		"redirect_uri":          {p.RedirectURL},
+		"scope":                 {strings.Join(p.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {challenge},
+		"code_challenge_method": {"S256"},
+	}
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return metadata.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems the authorization code the provider redirected the user back
+// with, and returns the claims of the verified ID token. The nonce must be the
+// one sent to AuthCodeURL, so an ID token can not be replayed in another login.
+func (p *OIDCProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*IDTokenClaims, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.RedirectURL},
+		"code_verifier": {verifier},
+		"client_id":     {p.ClientID},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.ClientSecret != "" {
+		// client_secret_basic, the method every provider supports (RFC 6749 2.3.1)
+		req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+	}
+
+	var tokens struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.do(req, &tokens)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint of %s returned %d: %s", p.Name, status, strings.TrimSpace(tokens.Error+" "+tokens.ErrorDescription))
+	}
+	if tokens.IDToken == "" {
+		return nil, fmt.Errorf("token endpoint of %s returned no ID token", p.Name)
+	}
+	return p.verifyIDToken(ctx, tokens.IDToken, nonce)
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of an
+// ID token (OpenID Connect Core 3.1.3.7). The issuer must be exactly the one of
+// the discovery document, trailing slash included.
+func (p *OIDCProvider) verifyIDToken(ctx context.Context, raw, nonce string) (*IDTokenClaims, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &IDTokenClaims{}
+	keyfunc := func(token *jwt.Token) (any, error) {
+		return p.verificationKey(ctx, token)
+	}
+	token, err := jwt.ParseWithClaims(raw, claims, keyfunc,
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384", "EdDSA"}),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("ID token has no subject")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.ClientID {
+		return nil, errors.New("ID token was issued to another client")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, errors.New("ID token nonce does not match")
+	}
+	return claims, nil
+}
+
+// verificationKey is the jwt.Keyfunc finding the provider key an ID token was
+// signed with. Keys are fetched again when the token names an unknown key, at
+// most once per oidcKeysRefreshInterval.
+func (p *OIDCProvider) verificationKey(ctx context.Context, token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	p.mu.Lock()
+	key, ok := p.lookupKey(kid)
+	stale := time.Since(p.keysFetchedAt) > oidcKeysRefreshInterval
+	p.mu.Unlock()
+	if !ok && stale {
+		if err := p.fetchKeys(ctx); err != nil {
+			return nil, err
+		}
+		p.mu.Lock()
+		key, ok = p.lookupKey(kid)
+		p.mu.Unlock()
+	}
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+
+	// Each key only verifies the algorithms of its type.
+	switch key.(type) {
+	case *rsa.PublicKey:
+		ok = strings.HasPrefix(token.Method.Alg(), "RS") || strings.HasPrefix(token.Method.Alg(), "PS")
+	case *ecdsa.PublicKey:
+		ok = strings.HasPrefix(token.Method.Alg(), "ES")
+	case ed25519.PublicKey:
+		ok = token.Method.Alg() == jwt.SigningMethodEdDSA.Alg()
+	}
+	if !ok {
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+	return key, nil
+}
+
+// lookupKey returns the key of a kid. Tokens without a kid are only accepted
+// from providers with a single key. The caller holds p.mu.
+func (p *OIDCProvider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// discover fetches the discovery document of the provider, once it succeeded.
+func (p *OIDCProvider) discover(ctx context.Context) (*oidcMetadata, error) {
+	p.mu.Lock()
+	metadata := p.metadata
+	p.mu.Unlock()
+	if metadata != nil {
+		return metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.Issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	metadata = &oidcMetadata{}
+	status, err := p.do(req, metadata)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("discovery of %s returned %d", p.Name, status)
+	}
+	// The issuer must be the one configured, or the provider could be spoofed
+	// (OpenID Connect Discovery 4.3).
+	if strings.TrimSuffix(metadata.Issuer, "/") != p.Issuer {
+		return nil, fmt.Errorf("discovery of %s returned issuer %q", p.Name, metadata.Issuer)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery of %s is missing endpoints", p.Name)
+	}
+
+	p.mu.Lock()
+	p.metadata = metadata
+	p.mu.Unlock()
+	return metadata, nil
+}
+
+// fetchKeys fetches the signing keys of the provider, skipping the keys of
+// unsupported types.
+func (p *OIDCProvider) fetchKeys(ctx context.Context) error {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadata.JWKSURI, nil)
+	if err != nil {
+		return err
+	}
+	var jwks JWKS
+	status, err := p.do(req, &jwks)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("keys of %s returned %d", p.Name, status)
+	}
+
+	keys := make(map[string]any)
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+
+	p.mu.Lock()
+	p.keys = keys
+	p.keysFetchedAt = time.Now()
+	p.mu.Unlock()
+	return nil
+}
+
+// do sends a request to the provider and decodes its JSON response, returning
+// its status code.
+func (p *OIDCProvider) do(req *http.Request, v any) (int, error) {
+	resp, err := p.Client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseBytes))
+	if err != nil {
+		return 0, err
+	}
+	if err := json.Unmarshal(body, v); err != nil && resp.StatusCode == http.StatusOK {
+		return 0, fmt.Errorf("invalid response from %s: %w", p.Name, err)
+	}
+	return resp.StatusCode, nil
+}
+
+// NewPKCEVerifier generates a PKCE code verifier, returning the verifier sent
+// when redeeming the code and the S256 challenge sent with the authorization
+// request (RFC 7636).
+func NewPKCEVerifier() (string, string, error) {
+	verifier, _, err := newRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// publicKey converts an RSA, EC or Ed25519 JWK to a public key.
+func (k JWK) publicKey() (any, error) {
+	decode := func(s string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil || len(b) == 0 {
+			return nil, errors.New("invalid JWK")
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil || !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid JWK")
+		}
+		if n.BitLen() < MinimumRSAKeyBits {
+			return nil, fmt.Errorf("RSA keys must have at least %d bits", MinimumRSAKeyBits)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("invalid JWK")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if k.Crv != "Ed25519" || err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid JWK")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
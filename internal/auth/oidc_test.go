@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// mockIdP is an OpenID Connect provider whose token endpoint returns an ID token
+// with the issuer of tokenIssuer, and discovery the issuer of issuer.
+type mockIdP struct {
+	server      *httptest.Server
+	key         *rsa.PrivateKey
+	issuer      string
+	tokenIssuer string
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, MinimumRSAKeyBits)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	idp := &mockIdP{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.issuer,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(JWKS{Keys: []JWK{{
+			Kty: "RSA",
+			Kid: "test",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now()
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   idp.tokenIssuer,
+			"aud":   "game-scores",
+			"sub":   "player-1",
+			"nonce": "nonce",
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Minute).Unix(),
+		})
+		token.Header["kid"] = "test"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			t.Errorf("SignedString() error = %v", err)
+		}
+		json.NewEncoder(w).Encode(map[string]string{"id_token": idToken})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func TestOIDCProviderExchangeIssuer(t *testing.T) {
+	tests := []struct {
+		name        string
+		issuer      string // Of the discovery document, relative to the server URL
+		configured  string // Of the configuration
+		tokenIssuer string // Of the ID token
+		wantErr     bool
+	}{
+		{name: "without trailing slash", issuer: "", configured: "", tokenIssuer: ""},
+		{name: "with trailing slash", issuer: "/", configured: "/", tokenIssuer: "/"},
+		{name: "trailing slash not configured", issuer: "/", configured: "", tokenIssuer: "/"},
+		{name: "token without the trailing slash", issuer: "/", configured: "/", tokenIssuer: "", wantErr: true},
+		{name: "token with an extra trailing slash", issuer: "", configured: "", tokenIssuer: "/", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			idp.issuer = idp.server.URL + tt.issuer
+			idp.tokenIssuer = idp.server.URL + tt.tokenIssuer
+
+			provider := NewOIDCProvider("mock", idp.server.URL+tt.configured, "game-scores", "secret", "http://localhost/auth/mock/callback")
+			claims, err := provider.Exchange(context.Background(), "code", "verifier", "nonce")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Exchange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && claims.Subject != "player-1" {
+				t.Errorf("Exchange() subject = %q, want %q", claims.Subject, "player-1")
+			}
+		})
+	}
+}
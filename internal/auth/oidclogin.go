@@ -0,0 +1,101 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// OIDCLoginClaims are the claims of the token kept by the user's browser, in a
+// cookie, while they log in at a provider. The state is also sent through the
+// provider, and must come back with the code, so a login started by someone
+// else can not be completed in the user's browser. The verifier and nonce never
+// leave the API and the browser. Logins started by a logged-in user to link the
+// account of the provider to them have the user as subject.
+type OIDCLoginClaims struct {
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	Device   string `json:"device,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// LinkingUserID returns the ID of the user linking the account of the provider,
+// and false for a login.
+func (c *OIDCLoginClaims) LinkingUserID() (uuid.UUID, bool) {
+	if c.Subject == "" {
+		return uuid.Nil, false
+	}
+	id, err := uuid.Parse(c.Subject)
+	return id, err == nil
+}
+
+// oidcLoginAudience is the audience of federated login tokens. It differs from
+// the audience of access tokens, so neither is accepted as the other.
+func oidcLoginAudience(keys *KeySet) string {
+	return keys.Audience + "/oidc"
+}
+
+// GenerateOIDCLogin starts a federated login at a provider, for the session of
+// the given device, or the linking of an account of the provider to the given
+// user when it is not uuid.Nil. It returns the token kept by the user's browser,
+// valid for the given duration, and the URL of the provider the user is
+// redirected to.
+func GenerateOIDCLogin(ctx context.Context, provider *OIDCProvider, device string, linking uuid.UUID, keys *KeySet, ttl time.Duration) (string, string, error) {
+	state, _, err := newRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, _, err := newRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+	verifier, challenge, err := NewPKCEVerifier()
+	if err != nil {
+		return "", "", err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, challenge)
+	if err != nil {
+		return "", "", err
+	}
+
+	now := time.Now()
+	claims := &OIDCLoginClaims{
+		Provider: provider.Name,
+		State:    state,
+		Nonce:    nonce,
+		Verifier: verifier,
+		Device:   device,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    keys.Issuer,
+			Audience:  jwt.ClaimStrings{oidcLoginAudience(keys)},
+		},
+	}
+	if linking != uuid.Nil {
+		claims.Subject = linking.String()
+	}
+	token, err := keys.sign(claims)
+	if err != nil {
+		return "", "", err
+	}
+	return token, authURL, nil
+}
+
+// ParseOIDCLogin verifies a token signed by GenerateOIDCLogin and returns its claims.
+func ParseOIDCLogin(tokenString string, keys *KeySet) (*OIDCLoginClaims, error) {
+	claims := &OIDCLoginClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.verificationKey, keys.parserOptions(oidcLoginAudience(keys))...)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
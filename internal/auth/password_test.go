@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testArgon2Params keep the tests fast, the format is the same as with the defaults.
+var testArgon2Params = Argon2Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestArgon2idHasherVerify(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2Params)
+
+	argon2idHash, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	if !strings.HasPrefix(argon2idHash, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Fatalf("Hash() = %q, want an argon2id hash with the hasher's parameters", argon2idHash)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+	// Hashes made with other parameters keep working.
+	stronger, err := NewArgon2idHasher(DefaultArgon2Params).Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		encoded  string
+		password string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "argon2id, right password", encoded: argon2idHash, password: "correct horse", want: true},
+		{name: "argon2id, wrong password", encoded: argon2idHash, password: "correct horse!"},
+		{name: "argon2id, other parameters", encoded: stronger, password: "correct horse", want: true},
+		{name: "bcrypt, right password", encoded: string(bcryptHash), password: "correct horse", want: true},
+		{name: "bcrypt, wrong password", encoded: string(bcryptHash), password: "battery staple"},
+		{name: "unknown format", encoded: "plain text", password: "plain text", wantErr: true},
+		{name: "malformed argon2id", encoded: "$argon2id$v=19$m=64,t=1,p=1$c2FsdA", password: "correct horse", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := hasher.Verify(tt.encoded, tt.password)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArgon2idHasherHashIsSalted(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2Params)
+
+	first, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	second, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	if first == second {
+		t.Errorf("Hash() returned %q twice, want a new salt each time", first)
+	}
+}
+
+func TestArgon2idHasherNeedsRehash(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2Params)
+
+	current, err := hasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("GenerateFromPassword() error = %v", err)
+	}
+
+	changed := func(change func(*Argon2Params)) string {
+		params := testArgon2Params
+		change(&params)
+		encoded, err := NewArgon2idHasher(params).Hash("correct horse")
+		if err != nil {
+			t.Fatalf("Hash() error = %v", err)
+		}
+		return encoded
+	}
+
+	tests := []struct {
+		name    string
+		encoded string
+		want    bool
+	}{
+		{name: "current parameters", encoded: current},
+		{name: "bcrypt", encoded: string(bcryptHash), want: true},
+		{name: "other memory", encoded: changed(func(p *Argon2Params) { p.Memory = 128 }), want: true},
+		{name: "other iterations", encoded: changed(func(p *Argon2Params) { p.Iterations = 2 }), want: true},
+		{name: "other parallelism", encoded: changed(func(p *Argon2Params) { p.Parallelism = 2 }), want: true},
+		{name: "other salt length", encoded: changed(func(p *Argon2Params) { p.SaltLength = 8 }), want: true},
+		{name: "other key length", encoded: changed(func(p *Argon2Params) { p.KeyLength = 16 }), want: true},
+		{name: "malformed", encoded: "$argon2id$garbage", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasher.NeedsRehash(tt.encoded); got != tt.want {
+				t.Errorf("NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeArgon2id(t *testing.T) {
+	encoded := encodeArgon2id(testArgon2Params, []byte("0123456789abcdef"), []byte("0123456789abcdef0123456789abcdef"))
+	params, salt, key, err := decodeArgon2id(encoded)
+	if err != nil {
+		t.Fatalf("decodeArgon2id() error = %v", err)
+	}
+	if params != testArgon2Params || string(salt) != "0123456789abcdef" || string(key) != "0123456789abcdef0123456789abcdef" {
+		t.Errorf("decodeArgon2id() = %+v, %q, %q, want the encoded parameters, salt and key", params, salt, key)
+	}
+
+	malformed := []struct {
+		name    string
+		encoded string
+	}{
+		{name: "empty", encoded: ""},
+		{name: "missing hash", encoded: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA"},
+		{name: "extra part", encoded: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5$a2V5"},
+		{name: "missing version", encoded: "$argon2id$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5"},
+		{name: "unsupported version", encoded: "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5"},
+		{name: "missing parameters", encoded: "$argon2id$v=19$m=64$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5"},
+		{name: "parallelism overflow", encoded: "$argon2id$v=19$m=64,t=1,p=256$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5"},
+		{name: "salt not base64", encoded: "$argon2id$v=19$m=64,t=1,p=1$not*base64$a2V5a2V5"},
+		{name: "hash not base64", encoded: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$not*base64"},
+		{name: "empty hash", encoded: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$"},
+	}
+	for _, tt := range malformed {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := decodeArgon2id(tt.encoded); err == nil {
+				t.Errorf("decodeArgon2id(%q) error = nil, want an error", tt.encoded)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package auth
+
+import "time"
+
+// DefaultPasswordResetTTL is how long an emailed password reset token is valid.
+const DefaultPasswordResetTTL = time.Hour
+
+// NewPasswordResetToken generates a random password reset token, returning the
+// token emailed to the user and the hash to store.
+func NewPasswordResetToken() (string, string, error) {
+	return newRandomToken()
+}
+
+// HashPasswordResetToken returns the hash a password reset token is stored and
+// looked up by.
+func HashPasswordResetToken(token string) string {
+	return hashRandomToken(token)
+}
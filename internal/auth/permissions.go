@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/user"
+
+	"github.com/google/uuid"
+)
+
+// Permissions granted to roles. Routes declare the permission they require with
+// the RequirePermission middleware.
+const (
+	// PermissionCreateGames allows adding games.
+	PermissionCreateGames = "games:create"
+	// PermissionManageGames allows managing games: editing them, adding seasons,
+	// their signing secret and the API keys of their servers.
+	PermissionManageGames = "games:manage"
+	// PermissionManageMaintainers allows choosing who maintains games, and
+	// transferring their ownership.
+	PermissionManageMaintainers = "games:maintainers"
+	// PermissionModerateScores allows reading the score history of any player and
+	// removing scores from leaderboards.
+	PermissionModerateScores = "scores:moderate"
+	// PermissionBanUsers allows banning users, and ending every session of a
+	// user, below the role of whoever does it.
+	PermissionBanUsers = "users:ban"
+	// PermissionManageRoles allows changing the role of users.
+	PermissionManageRoles = "users:manage_roles"
+	// PermissionUnlockUsers allows inspecting and clearing failed logins, lifting
+	// the lockouts of usernames and client IPs.
+	PermissionUnlockUsers = "users:unlock"
+)
+
+// Permissions that can be granted to server keys.
+const (
+	// PermissionSubmitScores allows joining any player to the key's game and
+	// submitting their scores.
+	PermissionSubmitScores = "scores:submit"
+	// PermissionReadLeaderboard allows reading the leaderboard of the key's game,
+	// including the score history of any of its players.
+	PermissionReadLeaderboard = "leaderboard:read"
+)
+
+// ServerKeyPermissions lists every permission a server key can be granted.
+var ServerKeyPermissions = []string{PermissionSubmitScores, PermissionReadLeaderboard}
+
+// Roles lists every role, from the least to the most privileged.
+var Roles = []user.Role{user.RolePlayer, user.RoleModerator, user.RoleGameOwner, user.RoleAdmin}
+
+// RolePermissions is the permission catalog, the permissions granted to each
+// role on every game. Players have no extra permission, they can only play.
+// Game owners can create games, and only manage the games they own or maintain.
+var RolePermissions = map[user.Role][]string{
+	user.RolePlayer: {},
+	user.RoleModerator: {
+		PermissionModerateScores,
+		PermissionBanUsers,
+	},
+	user.RoleGameOwner: {
+		PermissionCreateGames,
+	},
+	user.RoleAdmin: {
+		PermissionCreateGames,
+		PermissionManageGames,
+		PermissionManageMaintainers,
+		PermissionModerateScores,
+		PermissionBanUsers,
+		PermissionManageRoles,
+		PermissionUnlockUsers,
+	},
+}
+
+// Roles users can have on a single game, whatever their role.
+const (
+	GameRoleOwner      = "owner"
+	GameRoleMaintainer = "maintainer"
+)
+
+// GameRolePermissions is the permission catalog of the roles on a single game,
+// the permissions granted on that game only.
+var GameRolePermissions = map[string][]string{
+	GameRoleOwner: {
+		PermissionManageGames,
+		PermissionManageMaintainers,
+		PermissionModerateScores,
+	},
+	GameRoleMaintainer: {
+		PermissionManageGames,
+		PermissionModerateScores,
+	},
+}
+
+// Outranks reports whether a role is more privileged than another. Unknown
+// roles rank below every role.
+func Outranks(role, other string) bool {
+	return slices.Index(Roles, user.Role(role)) > slices.Index(Roles, user.Role(other))
+}
+
+// HasPermission reports whether a role was granted a permission. Unknown roles
+// have no permission.
+func HasPermission(role, permission string) bool {
+	return slices.Contains(RolePermissions[user.Role(role)], permission)
+}
+
+// GameRole returns the role of a user on a game, or an empty string if they are
+// neither its owner nor one of its maintainers.
+func GameRole(ctx context.Context, db *ent.Client, userID uuid.UUID, gameID int) (string, error) {
+	owner, err := db.Game.
+		Query().
+		Where(game.ID(gameID), game.HasOwnerWith(user.ID(userID))).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if owner {
+		return GameRoleOwner, nil
+	}
+
+	maintainer, err := db.Game.
+		Query().
+		Where(game.ID(gameID), game.HasMaintainersWith(user.ID(userID))).
+		Exist(ctx)
+	if err != nil {
+		return "", err
+	}
+	if maintainer {
+		return GameRoleMaintainer, nil
+	}
+	return "", nil
+}
+
+// HasGamePermission reports whether a role on a game was granted a permission.
+func HasGamePermission(gameRole, permission string) bool {
+	return slices.Contains(GameRolePermissions[gameRole], permission)
+}
@@ -0,0 +1,227 @@
+package auth
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/revokedtoken"
+	"game-scores/ent/user"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultRevocationCacheSize is the number of lookups the revocation store keeps in memory.
+	DefaultRevocationCacheSize = 10_000
+	// DefaultRevocationCacheTTL is how long a lookup is trusted before asking the
+	// database again. Revocations made by other API instances take up to this
+	// long to be noticed.
+	DefaultRevocationCacheTTL = 30 * time.Second
+)
+
+// RevocationStore decides whether access tokens that are still unexpired were
+// revoked, either one by one through their jti, or all the tokens of a user
+// issued before a cutoff. Revocations are kept in the database, with a bounded
+// in-memory cache in front of it so most requests do not query it.
+type RevocationStore struct {
+	db    *ent.Client
+	cache *lruCache
+}
+
+// NewRevocationStore returns a revocation store caching up to size lookups for ttl.
+func NewRevocationStore(db *ent.Client, size int, ttl time.Duration) *RevocationStore {
+	return &RevocationStore{
+		db:    db,
+		cache: newLRUCache(size, ttl),
+	}
+}
+
+// IsRevoked reports whether a token was revoked. Tokens of users that no longer
+// exist, or are banned, are revoked too.
+func (s *RevocationStore) IsRevoked(ctx context.Context, claims *JWTClaims) (bool, error) {
+	revoked, err := s.tokenRevoked(ctx, claims.ID)
+	if err != nil || revoked {
+		return revoked, err
+	}
+
+	cutoff, err := s.userCutoff(ctx, claims.UserID)
+	if err != nil || !cutoff.exists || cutoff.banned {
+		return !cutoff.exists || cutoff.banned, err
+	}
+	if cutoff.validAfter.IsZero() || claims.IssuedAt == nil {
+		return false, nil
+	}
+	// Issue times are in whole seconds.
+	return claims.IssuedAt.Time.Before(cutoff.validAfter.Truncate(time.Second)), nil
+}
+
+// RevokeToken revokes a single token, e.g. when its session logs out.
+func (s *RevocationStore) RevokeToken(ctx context.Context, claims *JWTClaims, reason string) error {
+	create := s.db.RevokedToken.
+		Create().
+		SetJti(claims.ID).
+		SetReason(reason)
+	if claims.ExpiresAt != nil {
+		create.SetExpiresAt(claims.ExpiresAt.Time)
+	} else {
+		create.SetExpiresAt(time.Now())
+	}
+
+	// A token revoked twice stays revoked.
+	if err := create.Exec(ctx); err != nil && !ent.IsConstraintError(err) {
+		return err
+	}
+	s.cache.set(tokenKey(claims.ID), true)
+	return nil
+}
+
+// RevokeUser revokes every token issued to a user until now, e.g. when they are
+// banned, their role changes or they report a stolen session. Whether the user
+// is banned is cached along, so a ban or its lifting applies at once.
+func (s *RevocationStore) RevokeUser(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+	u, err := s.db.User.UpdateOneID(userID).SetTokensValidAfter(now).Save(ctx)
+	if err != nil {
+		return err
+	}
+	s.cache.set(userKey(userID), userCutoff{validAfter: now, exists: true, banned: u.Banned})
+	return nil
+}
+
+// RevokeDeletedUser revokes the tokens of a user whose account was deleted.
+// Tokens of users that no longer exist are revoked anyway, this only makes it
+// immediate for the lookups already cached.
+func (s *RevocationStore) RevokeDeletedUser(userID uuid.UUID) {
+	s.cache.set(userKey(userID), userCutoff{exists: false})
+}
+
+// PurgeExpired deletes the revocations of tokens that have expired by themselves.
+func (s *RevocationStore) PurgeExpired(ctx context.Context) (int, error) {
+	return s.db.RevokedToken.
+		Delete().
+		Where(revokedtoken.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+}
+
+func (s *RevocationStore) tokenRevoked(ctx context.Context, jti string) (bool, error) {
+	key := tokenKey(jti)
+	if cached, ok := s.cache.get(key); ok {
+		return cached.(bool), nil
+	}
+
+	revoked, err := s.db.RevokedToken.Query().Where(revokedtoken.Jti(jti)).Exist(ctx)
+	if err != nil {
+		return false, err
+	}
+	s.cache.set(key, revoked)
+	return revoked, nil
+}
+
+// userCutoff is the cached tokens_valid_after and ban of a user.
+type userCutoff struct {
+	validAfter time.Time
+	exists     bool
+	banned     bool
+}
+
+func (s *RevocationStore) userCutoff(ctx context.Context, userID uuid.UUID) (userCutoff, error) {
+	key := userKey(userID)
+	if cached, ok := s.cache.get(key); ok {
+		return cached.(userCutoff), nil
+	}
+
+	c := userCutoff{exists: true}
+	u, err := s.db.User.
+		Query().
+		Where(user.ID(userID)).
+		Select(user.FieldTokensValidAfter, user.FieldBanned).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		c.exists = false
+	case err != nil:
+		return userCutoff{}, err
+	default:
+		c.banned = u.Banned
+		if u.TokensValidAfter != nil {
+			c.validAfter = *u.TokensValidAfter
+		}
+	}
+
+	s.cache.set(key, c)
+	return c, nil
+}
+
+func tokenKey(jti string) string {
+	return "token:" + jti
+}
+
+func userKey(userID uuid.UUID) string {
+	return "user:" + userID.String()
+}
+
+// lruCache is a fixed-size cache evicting the least recently used entries, whose
+// entries also expire after a time to live.
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	order   *list.List // Most recently used first
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+func newLRUCache(size int, ttl time.Duration) *lruCache {
+	return &lruCache{
+		size:    size,
+		ttl:     ttl,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *lruCache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*cacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+func (c *lruCache) set(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, value: value, expiresAt: expiresAt})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
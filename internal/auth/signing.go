@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// NewSigningSecret generates a random secret for signing a game's score submissions.
+func NewSigningSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SignRequest returns the hex encoded HMAC-SHA256 signature of a request. The
+// method and path are signed along with the timestamp, nonce and body, so a
+// signature is only valid for the endpoint it was made for.
+func SignRequest(secret, timestamp, nonce, method, path string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.Join([]string{timestamp, nonce, method, path}, "\n") + "\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyRequestSignature reports whether signature is the signature of a
+// request, comparing in constant time.
+func VerifyRequestSignature(signature, secret, timestamp, nonce, method, path string, body []byte) bool {
+	expected := SignRequest(secret, timestamp, nonce, method, path, body)
+	return hmac.Equal([]byte(strings.ToLower(signature)), []byte(expected))
+}
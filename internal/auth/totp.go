@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters, the RFC 6238 defaults every authenticator app supports.
+const (
+	// TOTPDigits is the number of digits of a code.
+	TOTPDigits = 6
+	// TOTPPeriod is how long each code is shown.
+	TOTPPeriod = 30 * time.Second
+	// totpSkew is the number of time steps before and after the current one whose
+	// codes are accepted too, for clocks that drifted apart.
+	totpSkew = 1
+	// totpSecretBytes is the size of the secrets, the size of an HMAC-SHA1 key.
+	totpSecretBytes = 20
+)
+
+// totpEncoding encodes secrets in base32 without padding, as authenticator
+// apps expect them to be typed in.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewTOTPSecret generates a random TOTP secret, encoded in base32.
+func NewTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPURI returns the otpauth:// URI of a secret, which authenticator apps add
+// the account from, usually through a QR code. The account is shown under the
+// issuer's name.
+func TOTPURI(secret, issuer, account string) string {
+	escape := func(s string) string {
+		// Authenticator apps do not all decode "+" as a space.
+		return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+	}
+	return fmt.Sprintf("otpauth://totp/%s:%s?secret=%s&issuer=%s&algorithm=SHA1&digits=%d&period=%d",
+		escape(issuer), escape(account), secret, escape(issuer), TOTPDigits, int(TOTPPeriod.Seconds()))
+}
+
+// TOTPCode returns the code of a secret at the given time.
+func TOTPCode(secret string, t time.Time) (string, error) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return totpCode(key, totpStep(t)), nil
+}
+
+// ValidateTOTP checks a code of a secret at the given time, and returns the time
+// step it belongs to. Codes of the adjacent steps are accepted too, so callers
+// must only accept steps after the last one accepted, or a code seen by someone
+// else could be used again.
+func ValidateTOTP(secret, code string, t time.Time) (int64, bool) {
+	key, err := decodeTOTPSecret(secret)
+	if err != nil || len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := totpStep(t)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpStep returns the number of periods since the Unix epoch.
+func totpStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod.Seconds())
+}
+
+// totpCode computes the code of a time step (RFC 4226 HOTP with the step as counter).
+func totpCode(key []byte, step int64) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation: the last 4 bits pick the 31 bits the code is made of.
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for range TOTPDigits {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", TOTPDigits, value%modulo)
+}
+
+// decodeTOTPSecret decodes a base32 secret, ignoring case and spaces.
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	return totpEncoding.DecodeString(secret)
+}
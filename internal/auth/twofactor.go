@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"crypto/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/user"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultTwoFactorChallengeTTL is how long users have to enter their TOTP
+	// code once their password was accepted.
+	DefaultTwoFactorChallengeTTL = 5 * time.Minute
+	// RecoveryCodeCount is the number of recovery codes handed out at once.
+	RecoveryCodeCount = 10
+	// recoveryCodeBytes is the randomness of a recovery code, 10 base32 characters.
+	recoveryCodeBytes = 6
+)
+
+// TwoFactorRoles lists the roles whose permissions are only granted to sessions
+// that logged in with a second factor.
+var TwoFactorRoles = []user.Role{user.RoleAdmin}
+
+// RequiresTwoFactor reports whether the permissions of a role are only granted
+// to sessions that logged in with a second factor.
+func RequiresTwoFactor(role string) bool {
+	return slices.Contains(TwoFactorRoles, user.Role(role))
+}
+
+// TwoFactorChallengeClaims are the claims of the challenge token handed out
+// when the password of a user with two-factor authentication was accepted. It
+// is exchanged for the session's tokens together with a TOTP or recovery code.
+type TwoFactorChallengeClaims struct {
+	Device string `json:"device,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the ID of the user the challenge was issued to.
+func (c *TwoFactorChallengeClaims) UserID() (uuid.UUID, error) {
+	return uuid.Parse(c.Subject)
+}
+
+// twoFactorChallengeAudience is the audience of challenge tokens. It differs
+// from the audience of access tokens, so a challenge can not be used as one.
+func twoFactorChallengeAudience(keys *KeySet) string {
+	return keys.Audience + "/2fa"
+}
+
+// GenerateTwoFactorChallenge creates the challenge token of a user whose
+// password was accepted, for the session of the given device, valid for the
+// given duration.
+func GenerateTwoFactorChallenge(user *ent.User, device string, keys *KeySet, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &TwoFactorChallengeClaims{
+		Device: device,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID.String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    keys.Issuer,
+			Audience:  jwt.ClaimStrings{twoFactorChallengeAudience(keys)},
+		},
+	}
+	return keys.sign(claims)
+}
+
+// ParseTwoFactorChallenge verifies a token signed by GenerateTwoFactorChallenge
+// and returns its claims.
+func ParseTwoFactorChallenge(tokenString string, keys *KeySet) (*TwoFactorChallengeClaims, error) {
+	claims := &TwoFactorChallengeClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.verificationKey, keys.parserOptions(twoFactorChallengeAudience(keys))...)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+// NewRecoveryCodes generates a set of recovery codes, returning the codes handed
+// to the user and the hashes to store.
+func NewRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(b))
+		codes[i] = code[:5] + "-" + code[5:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the hash a recovery code is stored and looked up by.
+// Codes are compared without case, spaces or dashes, as users may type them.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return hashRandomToken(code)
+}
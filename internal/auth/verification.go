@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"time"
+
+	"game-scores/ent"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// DefaultEmailVerificationTTL is how long the link of a verification email is valid.
+const DefaultEmailVerificationTTL = 24 * time.Hour
+
+// EmailVerificationClaims are the claims of an email verification token. The
+// token is only valid for the email it was sent to, so it can not verify an
+// address the user changed to afterwards.
+type EmailVerificationClaims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the ID of the user the token was issued to.
+func (c *EmailVerificationClaims) UserID() (uuid.UUID, error) {
+	return uuid.Parse(c.Subject)
+}
+
+// emailVerificationAudience is the audience of email verification tokens. It
+// differs from the audience of access tokens, so neither is accepted as the other.
+func emailVerificationAudience(keys *KeySet) string {
+	return keys.Audience + "/verify-email"
+}
+
+// GenerateEmailVerificationToken creates a token verifying the current email of
+// a user, valid for the given duration, signed with the current key of the key set.
+func GenerateEmailVerificationToken(user *ent.User, keys *KeySet, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &EmailVerificationClaims{
+		Email: user.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID.String(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    keys.Issuer,
+			Audience:  jwt.ClaimStrings{emailVerificationAudience(keys)},
+		},
+	}
+	return keys.sign(claims)
+}
+
+// ParseEmailVerificationToken verifies a token signed by
+// GenerateEmailVerificationToken and returns its claims.
+func ParseEmailVerificationToken(tokenString string, keys *KeySet) (*EmailVerificationClaims, error) {
+	claims := &EmailVerificationClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, keys.verificationKey, keys.parserOptions(emailVerificationAudience(keys))...)
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
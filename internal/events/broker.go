@@ -0,0 +1,172 @@
+package events
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultHistory is the number of recent events kept per game for resuming streams.
+	DefaultHistory = 256
+	// subscriberBuffer is the number of events a subscriber can fall behind
+	// before it is dropped.
+	subscriberBuffer = 64
+)
+
+// Event is a message published on a game's stream. IDs are increasing per game
+// and carry the broker's epoch, so IDs handed out before a restart are never
+// mistaken for new ones.
+type Event struct {
+	ID   string
+	Type string
+	Data []byte
+}
+
+// Broker fans out the events of each game to its subscribers, and keeps the
+// latest events of each game so reconnecting subscribers can catch up.
+// Events only live in memory, so each API instance has its own streams.
+type Broker struct {
+	epoch   string
+	history int
+
+	mu      sync.Mutex
+	streams map[int]*stream
+}
+
+// stream holds the state of a single game's events.
+type stream struct {
+	lastSeq     uint64
+	recent      []sequencedEvent // The latest events, oldest first
+	subscribers map[*Subscription]struct{}
+}
+
+type sequencedEvent struct {
+	seq   uint64
+	event Event
+}
+
+// Subscription receives the events of a game as they are published.
+type Subscription struct {
+	// Events delivers the events. It is closed when the subscriber falls too far
+	// behind, so it can reconnect and catch up from the last event it received.
+	Events <-chan Event
+	// Latest is the ID of the last event published before subscribing, or empty
+	// if there is none.
+	Latest string
+
+	events chan Event
+	broker *Broker
+	gameID int
+}
+
+// NewBroker returns a broker keeping the given number of recent events per game.
+func NewBroker(history int) *Broker {
+	return &Broker{
+		epoch:   strconv.FormatInt(time.Now().UnixNano(), 36),
+		history: history,
+		streams: make(map[int]*stream),
+	}
+}
+
+// streamOf returns the stream of a game, creating it if needed. The caller must hold mu.
+func (b *Broker) streamOf(gameID int) *stream {
+	s, ok := b.streams[gameID]
+	if !ok {
+		s = &stream{subscribers: make(map[*Subscription]struct{})}
+		b.streams[gameID] = s
+	}
+	return s
+}
+
+// formatID returns the ID of the event with the given sequence number.
+func (b *Broker) formatID(seq uint64) string {
+	return b.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+// Publish sends an event to the subscribers of a game and returns it.
+// Subscribers that can not keep up are dropped rather than slowing down the publisher.
+func (b *Broker) Publish(gameID int, eventType string, data []byte) Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := b.streamOf(gameID)
+	s.lastSeq++
+	e := Event{ID: b.formatID(s.lastSeq), Type: eventType, Data: data}
+
+	s.recent = append(s.recent, sequencedEvent{seq: s.lastSeq, event: e})
+	if len(s.recent) > b.history {
+		s.recent = append(s.recent[:0], s.recent[1:]...)
+	}
+
+	for sub := range s.subscribers {
+		select {
+		case sub.events <- e:
+		default:
+			delete(s.subscribers, sub)
+			close(sub.events)
+		}
+	}
+	return e
+}
+
+// Subscribe starts receiving the events of a game. When lastEventID is set, the
+// events published after it are returned to be replayed first. The returned
+// bool is false when they can not all be replayed, because the ID is unknown or
+// the events after it are no longer kept.
+func (b *Broker) Subscribe(gameID int, lastEventID string) (*Subscription, []Event, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := b.streamOf(gameID)
+	events := make(chan Event, subscriberBuffer)
+	sub := &Subscription{
+		Events: events,
+		events: events,
+		broker: b,
+		gameID: gameID,
+	}
+	if s.lastSeq > 0 {
+		sub.Latest = b.formatID(s.lastSeq)
+	}
+	s.subscribers[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil, true
+	}
+
+	epoch, seqStr, ok := strings.Cut(lastEventID, "-")
+	if !ok || epoch != b.epoch {
+		return sub, nil, false
+	}
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil || seq > s.lastSeq {
+		return sub, nil, false
+	}
+	// The event right after the last one received must still be kept.
+	if seq < s.lastSeq && (len(s.recent) == 0 || s.recent[0].seq > seq+1) {
+		return sub, nil, false
+	}
+
+	var replay []Event
+	for _, e := range s.recent {
+		if e.seq > seq {
+			replay = append(replay, e.event)
+		}
+	}
+	return sub, replay, true
+}
+
+// Close stops the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	b := s.broker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	st := b.streams[s.gameID]
+	if _, ok := st.subscribers[s]; ok {
+		delete(st.subscribers, s)
+		close(s.events)
+	}
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"game-scores/ent"
+	"game-scores/ent/identity"
+	"game-scores/ent/passwordresettoken"
+	"game-scores/ent/recoverycode"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/user"
+	"game-scores/internal/decoder"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/google/uuid"
+)
+
+// ChangePasswordRequest defines the shape of the request body for changing the
+// password of the logged-in user.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// ChangeEmailRequest defines the shape of the request body for changing the
+// email of the logged-in user.
+type ChangeEmailRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// DeleteAccountRequest defines the shape of the request body for deleting the
+// account of the logged-in user.
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+// ChangePassword sets a new password for the logged-in user, after checking
+// their current one. Every session of the user ends, and a new one is started
+// for the caller, so their other devices have to log in with the new password.
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req ChangePasswordRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode change password request: %v", err)
+		return
+	}
+
+	if len(req.NewPassword) < MinimumPasswordLength {
+		http.Error(w, "Password must be at least "+strconv.Itoa(MinimumPasswordLength)+" characters long", http.StatusBadRequest)
+		return
+	}
+
+	u, ok := h.reauthenticate(w, r, req.CurrentPassword)
+	if !ok {
+		return
+	}
+
+	hashedPassword, err := h.Passwords.Hash(req.NewPassword)
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		if err := tx.User.UpdateOne(u).SetPasswordHash(hashedPassword).Exec(r.Context()); err != nil {
+			return err
+		}
+		return revokeRefreshTokens(r.Context(), tx.Client(), refreshtoken.HasUserWith(user.ID(u.ID)))
+	})
+	if err != nil {
+		log.Printf("Failed to change the password of %s: %v", u.Username, err)
+		http.Error(w, "Failed to change password", http.StatusInternalServerError)
+		return
+	}
+	if h.Revocations != nil {
+		if err := h.Revocations.RevokeUser(r.Context(), u.ID); err != nil {
+			log.Printf("Failed to revoke access tokens of %s: %v", u.Username, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	// The new session keeps the second factor the caller logged in with.
+	claims, _ := auth_middleware.ClaimsFromContext(r.Context())
+	response, err := h.issueTokens(r.Context(), h.Database, u, uuid.New(), "", claims.TwoFactor)
+	if err != nil {
+		log.Printf("Failed to issue tokens: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s changed their password", u.Username)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ChangeEmail sets a new email for the logged-in user, after checking their
+// password. The new email is unverified until the user follows the link sent to it.
+func (h *UserHandler) ChangeEmail(w http.ResponseWriter, r *http.Request) {
+	var req ChangeEmailRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode change email request: %v", err)
+		return
+	}
+
+	email, err := validate.Email(req.Email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	u, ok := h.reauthenticate(w, r, req.Password)
+	if !ok {
+		return
+	}
+	if validate.NormalizeEmail(u.Email) == email {
+		http.Error(w, "Email is unchanged", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.Database.User.
+		UpdateOne(u).
+		SetEmail(email).
+		SetEmailVerified(false).
+		Save(r.Context())
+	if ent.IsConstraintError(err) {
+		http.Error(w, "Email is already in use", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to change the email of %s: %v", u.Username, err)
+		http.Error(w, "Failed to change email", http.StatusInternalServerError)
+		return
+	}
+
+	// The email can be verified later, so a failure is only logged.
+	if err := h.sendVerificationEmail(r.Context(), updated); err != nil {
+		log.Printf("Failed to send the verification email to %s: %v", u.Username, err)
+	}
+
+	log.Printf("User %s changed their email", u.Username)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"message": "Email changed, a verification email was sent"})
+}
+
+// DeleteAccount deletes the logged-in user, after checking their password,
+// together with their scores, score history and sessions, so they disappear
+// from every leaderboard. Games they own are left without an owner.
+func (h *UserHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	var req DeleteAccountRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode delete account request: %v", err)
+		return
+	}
+
+	u, ok := h.reauthenticate(w, r, req.Password)
+	if !ok {
+		return
+	}
+
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		if _, err := tx.Score.Delete().Where(score.HasUserWith(user.ID(u.ID))).Exec(r.Context()); err != nil {
+			return err
+		}
+		if _, err := tx.ScoreSubmission.Delete().Where(scoresubmission.HasUserWith(user.ID(u.ID))).Exec(r.Context()); err != nil {
+			return err
+		}
+		if _, err := tx.RefreshToken.Delete().Where(refreshtoken.HasUserWith(user.ID(u.ID))).Exec(r.Context()); err != nil {
+			return err
+		}
+		if _, err := tx.PasswordResetToken.Delete().Where(passwordresettoken.HasUserWith(user.ID(u.ID))).Exec(r.Context()); err != nil {
+			return err
+		}
+		if _, err := tx.RecoveryCode.Delete().Where(recoverycode.HasUserWith(user.ID(u.ID))).Exec(r.Context()); err != nil {
+			return err
+		}
+		if _, err := tx.Identity.Delete().Where(identity.HasUserWith(user.ID(u.ID))).Exec(r.Context()); err != nil {
+			return err
+		}
+		return tx.User.DeleteOne(u).Exec(r.Context())
+	})
+	if err != nil {
+		log.Printf("Failed to delete the account of %s: %v", u.Username, err)
+		http.Error(w, "Failed to delete account", http.StatusInternalServerError)
+		return
+	}
+	if h.Revocations != nil {
+		h.Revocations.RevokeDeletedUser(u.ID)
+	}
+
+	log.Printf("User %s deleted their account", u.Username)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reauthenticate loads the logged-in user and checks their password, writing
+// the error response when either fails.
+func (h *UserHandler) reauthenticate(w http.ResponseWriter, r *http.Request, password string) (*ent.User, bool) {
+	u, ok := h.currentUser(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	valid, err := h.Passwords.Verify(u.PasswordHash, password)
+	if err != nil {
+		log.Printf("Failed to verify the password of %s: %v", u.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+	if !valid {
+		http.Error(w, "Invalid password", http.StatusForbidden)
+		return nil, false
+	}
+	return u, true
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	"game-scores/ent"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// BanUser bans the user named in the URL: their sessions end, their access
+// tokens are revoked, and they can not log in until they are unbanned.
+func (h *UserHandler) BanUser(w http.ResponseWriter, r *http.Request) {
+	h.setBanned(w, r, true)
+}
+
+// UnbanUser lifts the ban of the user named in the URL, who can log in again.
+func (h *UserHandler) UnbanUser(w http.ResponseWriter, r *http.Request) {
+	h.setBanned(w, r, false)
+}
+
+// setBanned bans or unbans the user named in the URL.
+func (h *UserHandler) setBanned(w http.ResponseWriter, r *http.Request, banned bool) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	target, ok := h.outrankedUser(w, r, claims)
+	if !ok {
+		return
+	}
+
+	err := withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		if err := tx.User.UpdateOne(target).SetBanned(banned).Exec(r.Context()); err != nil {
+			return err
+		}
+		if !banned {
+			return nil
+		}
+		return revokeRefreshTokens(r.Context(), tx.Client(), refreshtoken.HasUserWith(user.ID(target.ID)))
+	})
+	if err != nil {
+		log.Printf("Failed to change the ban of %s: %v", target.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Revoking the tokens also caches the ban, so it applies at once. Lifting a
+	// ban revokes nothing more, the ban revoked every token already.
+	if h.Revocations != nil {
+		if err := h.Revocations.RevokeUser(r.Context(), target.ID); err != nil {
+			log.Printf("Failed to revoke access tokens of %s: %v", target.Username, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if banned {
+		log.Printf("User %s banned %s", claims.Username, target.Username)
+	} else {
+		log.Printf("User %s unbanned %s", claims.Username, target.Username)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// outrankedUser returns the user named in the URL, if the role of the caller
+// is above theirs. Otherwise the error is written and false is returned, so
+// moderators can not act on each other, or on admins.
+func (h *UserHandler) outrankedUser(w http.ResponseWriter, r *http.Request, claims *auth.JWTClaims) (*ent.User, bool) {
+	username := chi.URLParam(r, "username")
+	target, err := h.Database.User.
+		Query().
+		Where(user.UsernameKey(validate.UsernameKey(username))).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return nil, false
+		}
+		log.Printf("Failed to query user %s: %v", username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !auth.Outranks(claims.Role, string(target.Role)) {
+		http.Error(w, "Forbidden: Only users with a lower role can be acted on", http.StatusForbidden)
+		return nil, false
+	}
+	return target, true
+}
+
+// rejectBanned refuses the login, or token refresh, of a banned user, and
+// reports whether it did.
+func rejectBanned(w http.ResponseWriter, u *ent.User) bool {
+	if !u.Banned {
+		return false
+	}
+	http.Error(w, "Account is banned", http.StatusForbidden)
+	return true
+}
@@ -4,11 +4,16 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strconv"
 
 	"game-scores/ent"
+	"game-scores/ent/game"
 
+	"game-scores/internal/auth"
 	"game-scores/internal/decoder"
 	auth_middleware "game-scores/internal/middleware"
+
+	"github.com/go-chi/chi/v5"
 )
 
 // GameHandler holds dependencies for game-related handlers.
@@ -17,19 +22,42 @@ type GameHandler struct {
 }
 
 // AddGameRequest defines the shape of the request body for adding a new game.
+// SortOrder is optional and defaults to "descending" (higher scores are better),
+// ScorePolicy is optional and defaults to "best" (keep the best score).
 type AddGameRequest struct {
 	Name        string `json:"game_name"`
 	Description string `json:"description"`
+	SortOrder   string `json:"sort_order,omitempty"`
+	ScorePolicy string `json:"score_policy,omitempty"`
+}
+
+// UpdateGameRequest defines the shape of the request body for editing a game.
+// Fields left out are not changed.
+type UpdateGameRequest struct {
+	Name        *string `json:"game_name,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 // GameResponse defines the shape of the list of games returned in the response.
+// SignedScores tells clients that score submissions must be signed. Owner is
+// omitted for games without one.
 type GameResponse struct {
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	SortOrder    string `json:"sort_order"`
+	ScorePolicy  string `json:"score_policy"`
+	SignedScores bool   `json:"signed_scores"`
+	Owner        string `json:"owner,omitempty"`
+}
+
+// SigningSecretResponse defines the shape of the response holding a game's new signing secret.
+type SigningSecretResponse struct {
+	SigningSecret string `json:"signing_secret"`
 }
 
-// AddGame handles the addition of a new game to the database.
+// AddGame handles the addition of a new game to the database. The user adding
+// the game becomes its owner.
 func (h *GameHandler) AddGame(w http.ResponseWriter, r *http.Request) {
 
 	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
@@ -38,12 +66,6 @@ func (h *GameHandler) AddGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Only admins can add games
-	if claims.Role != "admin" {
-		http.Error(w, "Forbidden: This action requires admin privileges", http.StatusForbidden)
-		return
-	}
-
 	var req AddGameRequest
 
 	err := decoder.DecodeJSONBody(w, r, &req)
@@ -57,11 +79,32 @@ func (h *GameHandler) AddGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	sortOrder := game.DefaultSortOrder
+	if req.SortOrder != "" {
+		sortOrder = game.SortOrder(req.SortOrder)
+		if err := game.SortOrderValidator(sortOrder); err != nil {
+			http.Error(w, "Sort order must be either \"descending\" or \"ascending\"", http.StatusBadRequest)
+			return
+		}
+	}
+
+	scorePolicy := game.DefaultScorePolicy
+	if req.ScorePolicy != "" {
+		scorePolicy = game.ScorePolicy(req.ScorePolicy)
+		if err := game.ScorePolicyValidator(scorePolicy); err != nil {
+			http.Error(w, "Score policy must be one of \"best\", \"latest\" or \"cumulative\"", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// Add game in the database using the Ent client
 	newGame, err := h.Database.Game.
 		Create().
 		SetName(req.Name).
 		SetDescription(req.Description).
+		SetSortOrder(sortOrder).
+		SetScorePolicy(scorePolicy).
+		SetOwnerID(claims.UserID).
 		Save(r.Context())
 
 	if ent.IsConstraintError(err) {
@@ -86,6 +129,7 @@ func (h *GameHandler) ListGames(w http.ResponseWriter, r *http.Request) {
 	// Get all games from the database
 	gamesList, err := h.Database.Game.
 		Query().
+		WithOwner().
 		All(r.Context())
 
 	if err != nil {
@@ -96,13 +140,160 @@ func (h *GameHandler) ListGames(w http.ResponseWriter, r *http.Request) {
 
 	gameResponses := make([]GameResponse, len(gamesList))
 	for i, game := range gamesList {
-		gameResponses[i] = GameResponse{
-			ID:          game.ID,
-			Name:        game.Name,
-			Description: game.Description,
-		}
+		gameResponses[i] = newGameResponse(game)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(gameResponses)
 }
+
+// UpdateGame edits the name and description of a game.
+func (h *GameHandler) UpdateGame(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	var req UpdateGameRequest
+
+	err = decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode update game request: %v", err)
+		return
+	}
+
+	update := h.Database.Game.UpdateOneID(gameID)
+	if req.Name != nil {
+		if *req.Name == "" {
+			http.Error(w, "Game name cannot be empty", http.StatusBadRequest)
+			return
+		}
+		update.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		update.SetDescription(*req.Description)
+	}
+
+	updatedGame, err := update.Save(r.Context())
+	if ent.IsNotFound(err) {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+	if ent.IsConstraintError(err) {
+		http.Error(w, "Game with this name already exists", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to update game %d: %v", gameID, err)
+		http.Error(w, "Failed to update game", http.StatusInternalServerError)
+		return
+	}
+
+	// Load the owner for the response.
+	updatedGame, err = h.Database.Game.Query().Where(game.ID(gameID)).WithOwner().Only(r.Context())
+	if err != nil {
+		log.Printf("Failed to load game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("Game updated successfully: %s, ID: %d", updatedGame.Name, updatedGame.ID)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(newGameResponse(updatedGame))
+}
+
+// RotateSigningSecret generates a new signing secret for a game, which score
+// submissions must be signed with from then on. Requests signed with the
+// previous secret are rejected.
+func (h *GameHandler) RotateSigningSecret(w http.ResponseWriter, r *http.Request) {
+
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	secret, err := auth.NewSigningSecret()
+	if err != nil {
+		log.Printf("Failed to generate signing secret: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = h.Database.Game.UpdateOneID(gameID).SetSigningSecret(secret).Exec(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to set the signing secret of game %d: %v", gameID, err)
+		http.Error(w, "Failed to set signing secret", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s rotated the signing secret of game %d", claims.Username, gameID)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(SigningSecretResponse{SigningSecret: secret})
+}
+
+// RemoveSigningSecret removes the signing secret of a game, its score
+// submissions no longer need to be signed.
+func (h *GameHandler) RemoveSigningSecret(w http.ResponseWriter, r *http.Request) {
+
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	err = h.Database.Game.UpdateOneID(gameID).ClearSigningSecret().Exec(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to remove the signing secret of game %d: %v", gameID, err)
+		http.Error(w, "Failed to remove signing secret", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s removed the signing secret of game %d", claims.Username, gameID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newGameResponse converts a game to its response. The owner edge must be loaded
+// for the owner to be included.
+func newGameResponse(g *ent.Game) GameResponse {
+	response := GameResponse{
+		ID:           g.ID,
+		Name:         g.Name,
+		Description:  g.Description,
+		SortOrder:    string(g.SortOrder),
+		ScorePolicy:  string(g.ScorePolicy),
+		SignedScores: g.SigningSecret != "",
+	}
+	if g.Edges.Owner != nil {
+		response.Owner = g.Edges.Owner.Username
+	}
+	return response
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/user"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// DefaultHistoryPageSize is the number of submissions returned when no limit is given.
+	DefaultHistoryPageSize = 50
+	MaximumHistoryPageSize = 500
+)
+
+// ScoreSubmissionResponse defines the shape of a single score submission in a player's history.
+type ScoreSubmissionResponse struct {
+	Score       string            `json:"score"`
+	Accepted    bool              `json:"accepted"`
+	SubmittedAt time.Time         `json:"submitted_at"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// ScoreHistoryResponse defines the shape of a page of a player's score history.
+// NextCursor is omitted on the last page.
+type ScoreHistoryResponse struct {
+	Submissions []ScoreSubmissionResponse `json:"submissions"`
+	NextCursor  string                    `json:"next_cursor,omitempty"`
+}
+
+// ListMyScoreHistory returns the logged-in player's score submissions for a game, newest first.
+func (h *GameScoresHandler) ListMyScoreHistory(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	h.writeScoreHistory(w, r, gameID, user.ID(claims.UserID))
+}
+
+// ListPlayerScoreHistory returns the score submissions for a game of the player
+// named in the URL, newest first, e.g. for moderators and game servers to spot
+// cheating.
+func (h *GameScoresHandler) ListPlayerScoreHistory(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	h.writeScoreHistory(w, r, gameID, user.UsernameKey(validate.UsernameKey(chi.URLParam(r, "username"))))
+}
+
+// writeScoreHistory responds with the page of the score submissions for a game
+// of the player matching the predicate requested with the "limit" and "cursor"
+// query parameters.
+func (h *GameScoresHandler) writeScoreHistory(w http.ResponseWriter, r *http.Request, gameID int, player predicate.User) {
+	limit, err := parseLimit(r.URL.Query().Get("limit"), DefaultHistoryPageSize, MaximumHistoryPageSize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Submission IDs grow with time, so the newest submissions have the highest IDs.
+	query := h.Database.ScoreSubmission.
+		Query().
+		Where(
+			scoresubmission.HasUserWith(player),
+			scoresubmission.HasGameWith(game.ID(gameID)),
+		).
+		Order(ent.Desc(scoresubmission.FieldID)).
+		Limit(limit + 1) // Fetch one extra entry to know if there is a next page
+
+	if c := r.URL.Query().Get("cursor"); c != "" {
+		lastID, err := decodeHistoryCursor(c)
+		if err != nil {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
+		query = query.Where(scoresubmission.IDLT(lastID))
+	}
+
+	submissions, err := query.All(r.Context())
+	if err != nil {
+		log.Printf("Failed to retrieve score history for game %d: %v", gameID, err)
+		http.Error(w, "Failed to retrieve score history", http.StatusInternalServerError)
+		return
+	}
+
+	var response ScoreHistoryResponse
+	if len(submissions) > limit {
+		submissions = submissions[:limit]
+		response.NextCursor = encodeHistoryCursor(submissions[limit-1].ID)
+	}
+
+	response.Submissions = make([]ScoreSubmissionResponse, len(submissions))
+	for i, s := range submissions {
+		response.Submissions[i] = ScoreSubmissionResponse{
+			Score:       strconv.FormatInt(s.Value, 10),
+			Accepted:    s.Accepted,
+			SubmittedAt: s.SubmittedAt,
+			Metadata:    s.Metadata,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// encodeHistoryCursor returns the opaque cursor handed to clients for the given submission ID.
+func encodeHistoryCursor(id int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(id)))
+}
+
+// decodeHistoryCursor parses a cursor created by encodeHistoryCursor.
+func decodeHistoryCursor(cursor string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	id, err := strconv.Atoi(string(raw))
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	return id, nil
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"game-scores/internal/auth"
+)
+
+// JWKSHandler publishes the public keys player tokens are signed with.
+type JWKSHandler struct {
+	Keys *auth.KeySet
+}
+
+// ServeJWKS returns the public signing keys as a JSON Web Key Set. Verifiers may
+// cache it for a few minutes.
+func (h *JWKSHandler) ServeJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(h.Keys.JWKS())
+}
@@ -0,0 +1,333 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/predicate"
+	"game-scores/ent/score"
+	"game-scores/ent/scoresubmission"
+	"game-scores/ent/season"
+
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// leaderboard scopes score queries to the ranking of a single game.
+// Entries are ranked by value, best first, and ties are broken by score ID so
+// the order is stable across pages.
+type leaderboard struct {
+	gameID int
+	// ascending is set for games where lower scores are better.
+	ascending bool
+	// policy is the game's score policy, it decides how the submissions of a
+	// time window add up to a player's score on the window's leaderboard.
+	policy game.ScorePolicy
+	// seasonID selects the scores of a season, 0 selects the scores submitted
+	// outside of any season.
+	seasonID int
+	// since limits the leaderboard to scores submitted from that time on, the
+	// zero time means all-time.
+	since time.Time
+}
+
+// newLeaderboard returns the leaderboard of a game in the given season, ranked in
+// the game's sort order. A nil season selects the scores outside of any season.
+func newLeaderboard(g *ent.Game, s *ent.Season) leaderboard {
+	l := leaderboard{
+		gameID:    g.ID,
+		ascending: g.SortOrder == game.SortOrderAscending,
+		policy:    g.ScorePolicy,
+	}
+	if s != nil {
+		l.seasonID = s.ID
+	}
+	return l
+}
+
+// seasonPredicate matches the scores of the given season, or the scores outside
+// of any season when it is 0.
+func seasonPredicate(seasonID int) predicate.Score {
+	if seasonID == 0 {
+		return score.Not(score.HasSeason())
+	}
+	return score.HasSeasonWith(season.ID(seasonID))
+}
+
+// predicates returns the filters that select the scores on the leaderboard.
+func (l leaderboard) predicates() []predicate.Score {
+	preds := []predicate.Score{
+		score.HasGameWith(game.ID(l.gameID)),
+		seasonPredicate(l.seasonID),
+	}
+	if l.ascending {
+		// Joining a game starts at 0, which would top a lower-is-better
+		// leaderboard, so only players who submitted a score are ranked.
+		preds = append(preds, score.AchievedAtNotNil())
+	}
+	return preds
+}
+
+// scores returns the unordered scores on the leaderboard. The scores of a
+// windowed leaderboard are computed from the submissions of the window, see
+// windowed, under the columns and name of the scores table, so they are
+// filtered, ranked and paginated like all-time scores.
+func (l leaderboard) scores(client *ent.Client) *ent.ScoreQuery {
+	query := client.Score.
+		Query().
+		Where(l.predicates()...)
+	if !l.since.IsZero() {
+		query.Modify(l.windowed)
+	}
+	return query
+}
+
+// windowed replaces the scores table of a query with the scores players made
+// during the window: on best games the best value they submitted, on cumulative
+// games the sum of their accepted submissions, and on latest games the value of
+// their last submission, which is always their current score. Players without
+// submissions in the window are not on its leaderboard.
+func (l leaderboard) windowed(s *entsql.Selector) {
+	builder := entsql.Dialect(s.Dialect())
+	scores := builder.Table(score.Table)
+	submissions := builder.Table(scoresubmission.Table).As("submissions")
+	submitted := submissions.C(scoresubmission.FieldValue)
+
+	var value string
+	switch {
+	case l.policy == game.ScorePolicyCumulative:
+		value = "CAST(SUM(" + submitted + ") AS BIGINT)"
+	case l.policy == game.ScorePolicyLatest:
+		value = scores.C(score.FieldValue)
+	case l.ascending:
+		value = entsql.Min(submitted)
+	default:
+		value = entsql.Max(submitted)
+	}
+
+	window := builder.Select(
+		scores.C(score.FieldID),
+		entsql.As(value, score.FieldValue),
+		scores.C(score.FieldCreatedAt),
+		entsql.As(entsql.Max(submissions.C(scoresubmission.FieldSubmittedAt)), score.FieldAchievedAt),
+	).
+		AppendSelect(scores.Columns(score.ForeignKeys...)...).
+		From(scores).
+		Join(submissions).
+		On(scores.C(score.FieldID), submissions.C(scoresubmission.ScoreColumn)).
+		Where(entsql.And(
+			entsql.EQ(scores.C(score.GameColumn), l.gameID),
+			entsql.GTE(submissions.C(scoresubmission.FieldSubmittedAt), l.since),
+		)).
+		GroupBy(scores.C(score.FieldID))
+	if l.policy != game.ScorePolicyBest {
+		// Only accepted submissions were added to the score.
+		window.Where(entsql.EQ(submissions.C(scoresubmission.FieldAccepted), true))
+	}
+
+	s.From(window.As(score.Table))
+}
+
+// withWindow limits the leaderboard to the time window requested with the
+// "window" and "tz" query parameters.
+func (l leaderboard) withWindow(r *http.Request) (leaderboard, error) {
+	loc := time.UTC
+	if tz := r.URL.Query().Get("tz"); tz != "" {
+		var err error
+		loc, err = time.LoadLocation(tz)
+		if err != nil {
+			return l, errors.New("tz must be an IANA time zone name, e.g. Europe/Rome")
+		}
+	}
+
+	since, err := windowStart(r.URL.Query().Get("window"), time.Now().In(loc))
+	if err != nil {
+		return l, err
+	}
+	l.since = since
+	return l, nil
+}
+
+// query returns all the scores on the leaderboard in ranking order.
+func (l leaderboard) query(client *ent.Client) *ent.ScoreQuery {
+	valueOrder := ent.Desc(score.FieldValue)
+	if l.ascending {
+		valueOrder = ent.Asc(score.FieldValue)
+	}
+	return l.scores(client).
+		Order(valueOrder, ent.Asc(score.FieldID))
+}
+
+// reversed returns all the scores on the leaderboard, worst first.
+func (l leaderboard) reversed(client *ent.Client) *ent.ScoreQuery {
+	valueOrder := ent.Asc(score.FieldValue)
+	if l.ascending {
+		valueOrder = ent.Desc(score.FieldValue)
+	}
+	return l.scores(client).
+		Order(valueOrder, ent.Desc(score.FieldID))
+}
+
+// replaceableBy matches a current score that a new value is at least as good as.
+// On lower-is-better games the joining score of 0 is only a placeholder, so a
+// score that was never submitted is always replaceable.
+func (l leaderboard) replaceableBy(value int64) predicate.Score {
+	if l.ascending {
+		return score.Or(score.ValueGTE(value), score.AchievedAtIsNil())
+	}
+	return score.ValueLTE(value)
+}
+
+// better matches the scores ranked strictly above the given value.
+func (l leaderboard) better(value int64) predicate.Score {
+	if l.ascending {
+		return score.ValueLT(value)
+	}
+	return score.ValueGT(value)
+}
+
+// worse matches the scores ranked strictly below the given value.
+func (l leaderboard) worse(value int64) predicate.Score {
+	if l.ascending {
+		return score.ValueGT(value)
+	}
+	return score.ValueLT(value)
+}
+
+// after matches the scores that come after the given entry in ranking order.
+func (l leaderboard) after(value int64, id int) predicate.Score {
+	return score.Or(
+		l.worse(value),
+		score.And(score.ValueEQ(value), score.IDGT(id)),
+	)
+}
+
+// before matches the scores that come before the given entry in ranking order.
+func (l leaderboard) before(value int64, id int) predicate.Score {
+	return score.Or(
+		l.better(value),
+		score.And(score.ValueEQ(value), score.IDLT(id)),
+	)
+}
+
+// count returns the number of scores on the leaderboard matching the extra filters.
+func (l leaderboard) count(ctx context.Context, client *ent.Client, preds ...predicate.Score) (int, error) {
+	return l.scores(client).
+		Where(preds...).
+		Count(ctx)
+}
+
+// rankOf returns the rank of a value on the leaderboard. Equal values share the
+// same rank, and the next distinct value skips the shared positions (1, 2, 2, 4).
+func (l leaderboard) rankOf(ctx context.Context, client *ent.Client, value int64) (int, error) {
+	better, err := l.count(ctx, client, l.better(value))
+	if err != nil {
+		return 0, err
+	}
+	return better + 1, nil
+}
+
+// entries converts a run of consecutive leaderboard scores into ranked responses.
+// The scores must be in ranking order with their user edge loaded, and position
+// is the 1-based position of the first one on the leaderboard.
+func (l leaderboard) entries(ctx context.Context, client *ent.Client, scores []*ent.Score, position int) ([]GameScoreResponse, error) {
+	responses := make([]GameScoreResponse, len(scores))
+	if len(scores) == 0 {
+		return responses, nil
+	}
+
+	// Only the first entry needs a query, the rest follow from their position.
+	rank, err := l.rankOf(ctx, client, scores[0].Value)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range scores {
+		if i > 0 && s.Value != scores[i-1].Value {
+			rank = position + i
+		}
+		responses[i] = GameScoreResponse{
+			Rank:     rank,
+			Username: s.Edges.User.Username,
+			Score:    strconv.FormatInt(s.Value, 10), // Convert int64 score to string
+		}
+	}
+	return responses, nil
+}
+
+// scoreCursor points at the last entry of a leaderboard page.
+type scoreCursor struct {
+	Value int64
+	ID    int
+}
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// encodeScoreCursor returns the opaque cursor handed to clients for the given score.
+func encodeScoreCursor(s *ent.Score) string {
+	raw := strconv.FormatInt(s.Value, 10) + ":" + strconv.Itoa(s.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeScoreCursor parses a cursor created by encodeScoreCursor.
+func decodeScoreCursor(cursor string) (scoreCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return scoreCursor{}, errInvalidCursor
+	}
+
+	valueStr, idStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return scoreCursor{}, errInvalidCursor
+	}
+
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil {
+		return scoreCursor{}, errInvalidCursor
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return scoreCursor{}, errInvalidCursor
+	}
+
+	return scoreCursor{Value: value, ID: id}, nil
+}
+
+// parseLimit reads the "limit" query parameter, falling back to def when it is
+// absent and rejecting values outside 1..max.
+func parseLimit(raw string, def, max int) (int, error) {
+	if raw == "" {
+		return def, nil
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil || limit < 1 || limit > max {
+		return 0, errors.New("limit must be a number between 1 and " + strconv.Itoa(max))
+	}
+	return limit, nil
+}
+
+// windowStart returns when the leaderboard window containing now started, in
+// now's location. Weeks start on Monday. The all-time window returns the zero time.
+func windowStart(window string, now time.Time) (time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch window {
+	case "", "all":
+		return time.Time{}, nil
+	case "daily":
+		return today, nil
+	case "weekly":
+		daysSinceMonday := (int(today.Weekday()) + 6) % 7
+		return today.AddDate(0, 0, -daysSinceMonday), nil
+	case "monthly":
+		return today.AddDate(0, 0, 1-today.Day()), nil
+	default:
+		return time.Time{}, errors.New("window must be one of daily, weekly, monthly or all")
+	}
+}
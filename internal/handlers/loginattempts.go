@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/loginattempt"
+	"game-scores/ent/predicate"
+	"game-scores/internal/auth"
+	"game-scores/internal/validate"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// MaximumLoginAttemptsListed is the number of failed logins returned when inspecting them.
+const MaximumLoginAttemptsListed = 100
+
+var (
+	// loginFailuresTotal counts the failed logins, by reason: "invalid_credentials"
+	// for a wrong username or password, "invalid_two_factor_code" for a wrong TOTP
+	// or recovery code, "throttled" for attempts refused because of earlier failures.
+	loginFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "login_failures_total",
+			Help: "Total number of failed logins.",
+		},
+		[]string{"reason"},
+	)
+
+	// loginLockoutsTotal counts the usernames and client IPs locked out.
+	loginLockoutsTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "login_lockouts_total",
+			Help: "Total number of usernames and client IPs locked out after failed logins.",
+		},
+	)
+)
+
+// LoginAttemptResponse defines the shape of a failed login returned in the response.
+type LoginAttemptResponse struct {
+	Username    string    `json:"username"`
+	ClientIP    string    `json:"client_ip"`
+	AttemptedAt time.Time `json:"attempted_at"`
+}
+
+// LoginAttemptsResponse defines the shape of the failed logins of a username or
+// client IP. Failures counts the recent ones, which delay or lock out logins
+// until RetryAt. Attempts lists the failed logins of the last day, newest first.
+type LoginAttemptsResponse struct {
+	Failures int                    `json:"failures"`
+	Locked   bool                   `json:"locked"`
+	RetryAt  *time.Time             `json:"retry_at,omitempty"`
+	Attempts []LoginAttemptResponse `json:"attempts"`
+}
+
+// ListLoginAttempts returns the failed logins of the username or client IP of
+// the username or ip query parameter.
+func (h *UserHandler) ListLoginAttempts(w http.ResponseWriter, r *http.Request) {
+	attempts, ok := loginAttemptsFilter(w, r)
+	if !ok {
+		return
+	}
+
+	var status auth.LoginStatus
+	var err error
+	if username := r.URL.Query().Get("username"); username != "" {
+		status, err = h.Logins.UsernameStatus(r.Context(), validate.UsernameKey(username))
+	} else {
+		status, err = h.Logins.IPStatus(r.Context(), r.URL.Query().Get("ip"))
+	}
+	if err != nil {
+		log.Printf("Failed to check failed logins: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	found, err := h.Database.LoginAttempt.
+		Query().
+		Where(attempts).
+		Order(ent.Desc(loginattempt.FieldAttemptedAt)).
+		Limit(MaximumLoginAttemptsListed).
+		All(r.Context())
+	if err != nil {
+		log.Printf("Failed to query failed logins: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	response := LoginAttemptsResponse{
+		Failures: status.Failures,
+		Locked:   status.Locked,
+		Attempts: make([]LoginAttemptResponse, len(found)),
+	}
+	if !status.RetryAt.IsZero() {
+		response.RetryAt = &status.RetryAt
+	}
+	for i, attempt := range found {
+		response.Attempts[i] = LoginAttemptResponse{
+			Username:    attempt.Username,
+			ClientIP:    attempt.ClientIP,
+			AttemptedAt: attempt.AttemptedAt,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ClearLoginAttempts deletes the failed logins of the username or client IP of
+// the username or ip query parameter, lifting their delays and lockouts.
+func (h *UserHandler) ClearLoginAttempts(w http.ResponseWriter, r *http.Request) {
+	attempts, ok := loginAttemptsFilter(w, r)
+	if !ok {
+		return
+	}
+
+	cleared, err := h.Logins.Clear(r.Context(), attempts)
+	if err != nil {
+		log.Printf("Failed to clear failed logins: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("Cleared %d failed logins of %s", cleared, r.URL.RawQuery)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// loginAttemptsFilter returns the failed logins selected by the username or ip
+// query parameter, writing the error response when neither or both are set.
+func loginAttemptsFilter(w http.ResponseWriter, r *http.Request) (predicate.LoginAttempt, bool) {
+	username, ip := r.URL.Query().Get("username"), r.URL.Query().Get("ip")
+	switch {
+	case username != "" && ip == "":
+		return loginattempt.Username(validate.UsernameKey(username)), true
+	case ip != "" && username == "":
+		return loginattempt.ClientIP(ip), true
+	default:
+		http.Error(w, "Either the username or the ip query parameter is required", http.StatusBadRequest)
+		return nil, false
+	}
+}
+
+// reserveLogin records a login attempt before its credentials are checked, and
+// refuses it if it comes too soon after failed ones, even with the right
+// credentials. The attempt must be released once checked, unless it failed.
+func (h *UserHandler) reserveLogin(w http.ResponseWriter, r *http.Request, username, ip string) (*auth.LoginReservation, bool) {
+	if h.Logins == nil {
+		return nil, true
+	}
+
+	attempt, retryAfter, err := h.Logins.Reserve(r.Context(), username, ip)
+	if err != nil {
+		log.Printf("Failed to check failed logins: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+	if retryAfter > 0 {
+		loginFailuresTotal.WithLabelValues("throttled").Inc()
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		http.Error(w, "Too many failed login attempts, try again later", http.StatusTooManyRequests)
+		return nil, false
+	}
+	return attempt, true
+}
+
+// releaseLogin releases a login attempt once its credentials were checked. It
+// still runs when the client went away, or the attempt would count as failed.
+func releaseLogin(r *http.Request, attempt *auth.LoginReservation) {
+	if attempt == nil {
+		return
+	}
+	if err := attempt.Release(context.WithoutCancel(r.Context())); err != nil {
+		log.Printf("Failed to release login attempt: %v", err)
+	}
+}
+
+// loginFailed records a login with a wrong username or password and rejects it.
+func (h *UserHandler) loginFailed(w http.ResponseWriter, r *http.Request, attempt *auth.LoginReservation, username, ip string) {
+	h.rejectLogin(w, r, attempt, username, ip, "invalid_credentials", "Invalid username or password")
+}
+
+// rejectLogin records a failed login, counted under the given reason, and
+// rejects it with the given message.
+func (h *UserHandler) rejectLogin(w http.ResponseWriter, r *http.Request, attempt *auth.LoginReservation, username, ip, reason, message string) {
+	loginFailuresTotal.WithLabelValues(reason).Inc()
+	if attempt != nil {
+		locked, err := attempt.Fail(r.Context())
+		if err != nil {
+			log.Printf("Failed to record failed login: %v", err)
+		}
+		if locked {
+			loginLockoutsTotal.Inc()
+			log.Printf("Logins of %s from %s locked out after too many failures", username, ip)
+		}
+	}
+	http.Error(w, message, http.StatusUnauthorized)
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/user"
+	"game-scores/internal/decoder"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// TransferOwnershipRequest defines the shape of the request body for changing the
+// owner of a game.
+type TransferOwnershipRequest struct {
+	Username string `json:"username"`
+}
+
+// GameMaintainersResponse defines the shape of the owner and maintainers of a
+// game returned in the response.
+type GameMaintainersResponse struct {
+	Owner       string   `json:"owner,omitempty"`
+	Maintainers []string `json:"maintainers"`
+}
+
+// ListMaintainers returns the owner and the maintainers of a game.
+func (h *GameHandler) ListMaintainers(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	g, err := h.Database.Game.
+		Query().
+		Where(game.ID(gameID)).
+		WithOwner().
+		WithMaintainers(func(q *ent.UserQuery) {
+			q.Order(ent.Asc(user.FieldUsername))
+		}).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to query maintainers of game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	response := GameMaintainersResponse{Maintainers: make([]string, len(g.Edges.Maintainers))}
+	if g.Edges.Owner != nil {
+		response.Owner = g.Edges.Owner.Username
+	}
+	for i, maintainer := range g.Edges.Maintainers {
+		response.Maintainers[i] = maintainer.Username
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// AddMaintainer lets a user manage a game. Adding a maintainer twice does nothing.
+func (h *GameHandler) AddMaintainer(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	username := chi.URLParam(r, "username")
+	target, ok := h.findUser(w, r, username)
+	if !ok {
+		return
+	}
+
+	err = h.Database.Game.UpdateOneID(gameID).AddMaintainerIDs(target.ID).Exec(r.Context())
+	if ent.IsNotFound(err) {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+	// The user already maintains the game.
+	if err != nil && !ent.IsConstraintError(err) {
+		log.Printf("Failed to add maintainer %s to game %d: %v", username, gameID, err)
+		http.Error(w, "Failed to add maintainer", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s is now a maintainer of game %d", username, gameID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveMaintainer stops a user from managing a game.
+func (h *GameHandler) RemoveMaintainer(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	username := chi.URLParam(r, "username")
+	target, ok := h.findUser(w, r, username)
+	if !ok {
+		return
+	}
+
+	updated, err := h.Database.Game.
+		Update().
+		Where(game.ID(gameID), game.HasMaintainersWith(user.ID(target.ID))).
+		RemoveMaintainerIDs(target.ID).
+		Save(r.Context())
+	if err != nil {
+		log.Printf("Failed to remove maintainer %s from game %d: %v", username, gameID, err)
+		http.Error(w, "Failed to remove maintainer", http.StatusInternalServerError)
+		return
+	}
+	if updated == 0 {
+		http.Error(w, "Maintainer not found", http.StatusNotFound)
+		return
+	}
+
+	log.Printf("User %s is no longer a maintainer of game %d", username, gameID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// TransferOwnership makes another user the owner of a game. The previous owner
+// keeps no role on the game, unless they are added back as a maintainer.
+func (h *GameHandler) TransferOwnership(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	var req TransferOwnershipRequest
+
+	err = decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode transfer ownership request: %v", err)
+		return
+	}
+
+	if req.Username == "" {
+		http.Error(w, "Username is required", http.StatusBadRequest)
+		return
+	}
+
+	newOwner, ok := h.findUser(w, r, req.Username)
+	if !ok {
+		return
+	}
+
+	err = h.Database.Game.
+		UpdateOneID(gameID).
+		SetOwnerID(newOwner.ID).
+		RemoveMaintainerIDs(newOwner.ID).
+		Exec(r.Context())
+	if ent.IsNotFound(err) {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to transfer game %d to %s: %v", gameID, req.Username, err)
+		http.Error(w, "Failed to transfer ownership", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s transferred game %d to %s", claims.Username, gameID, req.Username)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// findUser looks up a user by username, writing the error response when it fails.
+func (h *GameHandler) findUser(w http.ResponseWriter, r *http.Request, username string) (*ent.User, bool) {
+	u, err := h.Database.User.
+		Query().
+		Where(user.UsernameKey(validate.UsernameKey(username))).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return nil, false
+		}
+		log.Printf("Failed to query user %s: %v", username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+	return u, true
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/score"
+	"game-scores/ent/user"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RemovePlayerScore removes the score of the player named in the URL from a
+// game's current leaderboard, e.g. after it was forged. The player's submission
+// history is kept, and they start over from zero, still joined to the game.
+func (h *GameScoresHandler) RemovePlayerScore(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+	username := chi.URLParam(r, "username")
+
+	// Scores submitted during the running season make up the current leaderboard.
+	currentSeason, err := activeSeason(r.Context(), h.Database, gameID, time.Now())
+	if err != nil {
+		log.Printf("Failed to find the active season of game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	removed, err := h.Database.Score.
+		Query().
+		Where(
+			score.HasUserWith(user.UsernameKey(validate.UsernameKey(username))),
+			score.HasGameWith(game.ID(gameID)),
+			seasonPredicate(seasonIDOf(currentSeason)),
+		).
+		WithUser().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Score not found, player has no score in this game.", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to find the score of %s in game %d: %v", username, gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	var currentSeasonID *int
+	if currentSeason != nil {
+		currentSeasonID = &currentSeason.ID
+	}
+
+	// The score is replaced rather than reset, so the submissions applied to it
+	// are unlinked from it and no longer count on windowed leaderboards.
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		if err := tx.Score.DeleteOneID(removed.ID).Exec(r.Context()); err != nil {
+			return err
+		}
+		return tx.Score.
+			Create().
+			SetUser(removed.Edges.User).
+			SetGameID(gameID).
+			SetNillableSeasonID(currentSeasonID).
+			Exec(r.Context())
+	})
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// A concurrent request removed it first.
+			http.Error(w, "Score not found, player has no score in this game.", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to remove the score of %s from game %d: %v", username, gameID, err)
+		http.Error(w, "Failed to remove score", http.StatusInternalServerError)
+		return
+	}
+
+	// The ranks of everyone behind the player changed, spectators reload the leaderboard.
+	if h.Events != nil {
+		h.Events.Publish(gameID, ScoreEventReset, []byte("{}"))
+	}
+
+	log.Printf("User %s removed the score of %s from game %d", claims.Username, username, gameID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,407 @@
+package handler
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/identity"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// oidcLoginCookie holds the token of a federated login between its start and
+// the provider redirecting the user back.
+const oidcLoginCookie = "oidc_login"
+
+var (
+	// errFederatedEmailMissing is returned when a provider did not share a valid
+	// email, which every user must have.
+	errFederatedEmailMissing = errors.New("provider did not share a valid email")
+	// errFederatedEmailTaken is returned when a new identity's email belongs to a
+	// user it can not be linked to, as the provider's emails are not trusted or
+	// either side did not verify it.
+	errFederatedEmailTaken = errors.New("email belongs to another user")
+	// errIdentityTaken is returned when linking an account of a provider that
+	// already logs in another user.
+	errIdentityTaken = errors.New("account is linked to another user")
+)
+
+// OIDCLinkResponse defines the shape of the response starting the linking of an
+// account of a provider.
+type OIDCLinkResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+// StartOIDCLogin starts a federated login, redirecting the user to the login
+// page of the provider. The optional device query parameter labels the session
+// like the device of POST /login.
+func (h *UserHandler) StartOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.OIDCProviders[chi.URLParam(r, "provider")]
+	if !ok {
+		http.Error(w, "Unknown provider", http.StatusNotFound)
+		return
+	}
+
+	device := r.URL.Query().Get("device")
+	if len(device) > MaximumDeviceLength {
+		http.Error(w, "Device must not exceed "+strconv.Itoa(MaximumDeviceLength)+" characters", http.StatusBadRequest)
+		return
+	}
+
+	token, authURL, err := auth.GenerateOIDCLogin(r.Context(), provider, device, uuid.Nil, h.Keys, h.OIDCLoginTTL)
+	if err != nil {
+		log.Printf("Failed to start a login with %s: %v", provider.Name, err)
+		http.Error(w, "Provider is unavailable", http.StatusBadGateway)
+		return
+	}
+
+	h.setOIDCLoginCookie(w, provider.Name, token, int(h.OIDCLoginTTL.Seconds()))
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// LinkOIDCAccount starts linking an account of a provider to the logged-in user,
+// so it logs them in from then on. It returns the URL of the provider's login
+// page, which the client sends the user's browser to, along with the cookie of
+// the login, and the link is made once the provider redirects the browser back
+// to GET /auth/{provider}/callback.
+func (h *UserHandler) LinkOIDCAccount(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	provider, ok := h.OIDCProviders[chi.URLParam(r, "provider")]
+	if !ok {
+		http.Error(w, "Unknown provider", http.StatusNotFound)
+		return
+	}
+
+	token, authURL, err := auth.GenerateOIDCLogin(r.Context(), provider, "", claims.UserID, h.Keys, h.OIDCLoginTTL)
+	if err != nil {
+		log.Printf("Failed to start linking a %s account to %s: %v", provider.Name, claims.Username, err)
+		http.Error(w, "Provider is unavailable", http.StatusBadGateway)
+		return
+	}
+
+	h.setOIDCLoginCookie(w, provider.Name, token, int(h.OIDCLoginTTL.Seconds()))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(OIDCLinkResponse{AuthorizationURL: authURL})
+}
+
+// FinishOIDCLogin completes a federated login once the provider redirected the
+// user back with an authorization code. The user of the provider's account is
+// logged in, linked by a verified email to an existing user if the provider's
+// emails are trusted, or created, and gets the tokens of a new session, or the
+// challenge token of POST /login if they use two-factor authentication. Logins
+// started by LinkOIDCAccount link the account to their user instead.
+func (h *UserHandler) FinishOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.OIDCProviders[chi.URLParam(r, "provider")]
+	if !ok {
+		http.Error(w, "Unknown provider", http.StatusNotFound)
+		return
+	}
+
+	cookie, err := r.Cookie(oidcLoginCookie)
+	if err != nil {
+		http.Error(w, "Login was not started or expired", http.StatusBadRequest)
+		return
+	}
+	login, err := auth.ParseOIDCLogin(cookie.Value, h.Keys)
+	if err != nil || login.Provider != provider.Name {
+		http.Error(w, "Login was not started or expired", http.StatusBadRequest)
+		return
+	}
+	// Each login completes once, whatever the outcome.
+	h.setOIDCLoginCookie(w, provider.Name, "", -1)
+
+	query := r.URL.Query()
+	if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(login.State)) != 1 {
+		http.Error(w, "Invalid state", http.StatusBadRequest)
+		return
+	}
+	if reason := query.Get("error"); reason != "" {
+		log.Printf("Login with %s failed at the provider: %s", provider.Name, reason)
+		http.Error(w, "Login was denied by the provider", http.StatusUnauthorized)
+		return
+	}
+	if query.Get("code") == "" {
+		http.Error(w, "Missing authorization code", http.StatusBadRequest)
+		return
+	}
+
+	claims, err := provider.Exchange(r.Context(), query.Get("code"), login.Verifier, login.Nonce)
+	if err != nil {
+		log.Printf("Failed to complete a login with %s: %v", provider.Name, err)
+		http.Error(w, "Login with the provider could not be verified", http.StatusUnauthorized)
+		return
+	}
+
+	if userID, ok := login.LinkingUserID(); ok {
+		h.finishOIDCLink(w, r, provider, userID, claims)
+		return
+	}
+
+	u, err := h.federatedUser(r.Context(), provider, claims)
+	switch {
+	case errors.Is(err, errFederatedEmailMissing):
+		http.Error(w, "The provider did not share a valid email", http.StatusBadRequest)
+		return
+	case errors.Is(err, errFederatedEmailTaken):
+		http.Error(w, "Email is already in use, log in to link your "+provider.Name+" account", http.StatusConflict)
+		return
+	case ent.IsConstraintError(err):
+		// Another login of the same account, or with the same username, won the race.
+		log.Printf("Failed to link the %s account %s: %v", provider.Name, claims.Subject, err)
+		http.Error(w, "Login conflicted with another login, try again", http.StatusConflict)
+		return
+	case err != nil:
+		log.Printf("Failed to log in the %s account %s: %v", provider.Name, claims.Subject, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if rejectBanned(w, u) {
+		return
+	}
+
+	// Users with two-factor authentication still have to enter a code.
+	if u.TotpEnabled {
+		h.writeTwoFactorChallenge(w, u, login.Device)
+		return
+	}
+	h.loginSucceeded(w, r, u, login.Device, false)
+}
+
+// finishOIDCLink links the account of a provider to the user who started the
+// linking with LinkOIDCAccount.
+func (h *UserHandler) finishOIDCLink(w http.ResponseWriter, r *http.Request, provider *auth.OIDCProvider, userID uuid.UUID, claims *auth.IDTokenClaims) {
+	var u *ent.User
+	err := withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		var err error
+		u, err = tx.User.Get(r.Context(), userID)
+		if err != nil {
+			return err
+		}
+
+		linked, err := tx.Identity.
+			Query().
+			Where(identity.Provider(provider.Name), identity.Subject(claims.Subject)).
+			QueryUser().
+			OnlyID(r.Context())
+		switch {
+		case err == nil && linked == u.ID:
+			return nil
+		case err == nil:
+			return errIdentityTaken
+		case !ent.IsNotFound(err):
+			return err
+		}
+
+		create := tx.Identity.
+			Create().
+			SetProvider(provider.Name).
+			SetSubject(claims.Subject).
+			SetUser(u)
+		if email, err := validate.Email(claims.Email); err == nil {
+			create.SetEmail(email)
+		}
+		return create.Exec(r.Context())
+	})
+	switch {
+	case ent.IsNotFound(err):
+		http.Error(w, "User not found", http.StatusNotFound)
+		return
+	case errors.Is(err, errIdentityTaken):
+		http.Error(w, "The "+provider.Name+" account is already linked to another user", http.StatusConflict)
+		return
+	case ent.IsConstraintError(err):
+		log.Printf("Failed to link the %s account %s: %v", provider.Name, claims.Subject, err)
+		http.Error(w, "Linking conflicted with another login, try again", http.StatusConflict)
+		return
+	case err != nil:
+		log.Printf("Failed to link the %s account %s: %v", provider.Name, claims.Subject, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s linked their %s account", u.Username, provider.Name)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"message": "Account linked successfully"})
+}
+
+// federatedUser returns the user a provider's account logs in. Accounts seen
+// before log in the user they are linked to. New accounts are linked to the
+// user with the same email if the provider's emails are trusted and both the
+// provider and the user verified it, and otherwise create a new user, without
+// a usable password.
+func (h *UserHandler) federatedUser(ctx context.Context, provider *auth.OIDCProvider, claims *auth.IDTokenClaims) (*ent.User, error) {
+	email, emailErr := validate.Email(claims.Email)
+
+	linked, err := h.Database.Identity.
+		Query().
+		Where(identity.Provider(provider.Name), identity.Subject(claims.Subject)).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		update := h.Database.Identity.UpdateOne(linked).SetLastLoginAt(time.Now())
+		if emailErr == nil {
+			update.SetEmail(email)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, err
+		}
+		return linked.Edges.User, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if emailErr != nil {
+		return nil, errFederatedEmailMissing
+	}
+
+	var u *ent.User
+	var created bool
+	err = withTx(ctx, h.Database, func(tx *ent.Tx) error {
+		existing, err := tx.User.Query().Where(user.Email(email)).Only(ctx)
+		switch {
+		case err == nil:
+			// Otherwise whoever registered the email first would get, or give away, the account.
+			if !provider.TrustEmail || !claims.EmailVerified || !existing.EmailVerified {
+				return errFederatedEmailTaken
+			}
+			u = existing
+		case ent.IsNotFound(err):
+			u, err = h.createFederatedUser(ctx, tx, provider, email, claims)
+			if err != nil {
+				return err
+			}
+			created = true
+		default:
+			return err
+		}
+
+		return tx.Identity.
+			Create().
+			SetProvider(provider.Name).
+			SetSubject(claims.Subject).
+			SetEmail(email).
+			SetUser(u).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("User %s linked their %s account", u.Username, provider.Name)
+
+	// The account works without a verified email, so a failure is only logged.
+	if created && !u.EmailVerified {
+		if err := h.sendVerificationEmail(ctx, u); err != nil {
+			log.Printf("Failed to send the verification email to %s: %v", u.Username, err)
+		}
+	}
+	return u, nil
+}
+
+// createFederatedUser creates the user of a new provider account. Its password
+// is random, users set one with POST /password/forgot if they want one. Its
+// email is only verified if the provider's emails are trusted and the provider
+// verified it, otherwise the user verifies it like after registering.
+func (h *UserHandler) createFederatedUser(ctx context.Context, tx *ent.Tx, provider *auth.OIDCProvider, email string, claims *auth.IDTokenClaims) (*ent.User, error) {
+	username, key, err := federatedUsername(ctx, tx, email, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// A random token as password, which no one knows
+	password, _, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := h.Passwords.Hash(password)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := tx.User.
+		Create().
+		SetUsername(username).
+		SetUsernameKey(key).
+		SetUsernameSkeleton(validate.UsernameSkeleton(username)).
+		SetEmail(email).
+		SetEmailVerified(provider.TrustEmail && claims.EmailVerified).
+		SetPasswordHash(hashedPassword).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("User registered successfully: %s", u.Username)
+	return u, nil
+}
+
+// federatedUsername picks the username of a new provider account: the first of
+// its preferred username, name and email that is a valid username, with a
+// random number appended if it is taken or looks like a taken one, or "player"
+// with a random number.
+func federatedUsername(ctx context.Context, tx *ent.Tx, email string, claims *auth.IDTokenClaims) (string, string, error) {
+	local, _, _ := strings.Cut(email, "@")
+	base := "player"
+	for _, candidate := range []string{claims.PreferredUsername, claims.Name, local} {
+		candidate = strings.Join(strings.Fields(candidate), "_")
+		if _, _, err := validate.Username(candidate); err == nil {
+			base = candidate
+			break
+		}
+	}
+
+	candidate := base
+	for range 10 {
+		username, key, err := validate.Username(candidate)
+		if err == nil {
+			taken, err := tx.User.
+				Query().
+				Where(user.Or(user.UsernameKey(key), user.UsernameSkeleton(validate.UsernameSkeleton(username)))).
+				Exist(ctx)
+			if err != nil {
+				return "", "", err
+			}
+			if !taken {
+				return username, key, nil
+			}
+		}
+		candidate = fmt.Sprintf("%s-%04d", base, rand.IntN(10000))
+	}
+	return "", "", fmt.Errorf("no free username for %q", base)
+}
+
+// setOIDCLoginCookie sets the cookie of a federated login, only sent back to
+// the routes of its provider, or deletes it when maxAge is negative. It is sent
+// along the provider's redirect, a top-level navigation, so it is SameSite=Lax.
+func (h *UserHandler) setOIDCLoginCookie(w http.ResponseWriter, provider, value string, maxAge int) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcLoginCookie,
+		Value:    value,
+		Path:     "/auth/" + provider + "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(h.PublicURL, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/passwordresettoken"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	"game-scores/internal/decoder"
+	"game-scores/internal/mail"
+	"game-scores/internal/validate"
+)
+
+// ForgotPasswordRequest defines the shape of the request body for asking for a
+// password reset.
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordRequest defines the shape of the request body for resetting a
+// password with an emailed token.
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// errInvalidResetToken is returned when a reset token is unknown, expired or used.
+var errInvalidResetToken = errors.New("invalid password reset token")
+
+// ForgotPassword emails a password reset token to the user with the given email.
+// The response is the same whether or not such a user exists, so it can not be
+// used to find out who has an account. Only verified emails receive a token.
+func (h *UserHandler) ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var req ForgotPasswordRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode forgot password request: %v", err)
+		return
+	}
+
+	if req.Email == "" {
+		http.Error(w, "Email is required", http.StatusBadRequest)
+		return
+	}
+
+	u, err := h.Database.User.
+		Query().
+		Where(user.EmailEQ(validate.NormalizeEmail(req.Email)), user.EmailVerified(true)).
+		Only(r.Context())
+	switch {
+	case ent.IsNotFound(err):
+		log.Printf("Password reset requested for an unknown or unverified email")
+	case err != nil:
+		log.Printf("Failed to query user by email: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	default:
+		// A failure is only logged, an error would tell the account exists.
+		if err := h.sendPasswordResetEmail(r.Context(), u); err != nil {
+			log.Printf("Failed to send the password reset email to %s: %v", u.Username, err)
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"message": "If the email belongs to a verified account, a password reset email was sent"})
+}
+
+// ResetPassword sets a new password with an emailed reset token. The token, and
+// every other reset token of the user, can not be used again, and all the
+// sessions and access tokens of the user are revoked.
+func (h *UserHandler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req ResetPasswordRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode reset password request: %v", err)
+		return
+	}
+
+	if req.Token == "" {
+		http.Error(w, "Reset token is required", http.StatusBadRequest)
+		return
+	}
+	if len(req.Password) < MinimumPasswordLength {
+		http.Error(w, "Password must be at least "+strconv.Itoa(MinimumPasswordLength)+" characters long", http.StatusBadRequest)
+		return
+	}
+
+	var target *ent.User
+	now := time.Now()
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		token, err := tx.PasswordResetToken.
+			Query().
+			Where(
+				passwordresettoken.TokenHash(auth.HashPasswordResetToken(req.Token)),
+				passwordresettoken.UsedAtIsNil(),
+				passwordresettoken.ExpiresAtGT(now),
+			).
+			WithUser().
+			Only(r.Context())
+		if ent.IsNotFound(err) {
+			return errInvalidResetToken
+		}
+		if err != nil {
+			return err
+		}
+		target = token.Edges.User
+
+		// Use up every token of the user, only one request can succeed even if
+		// the token is presented several times at once.
+		used, err := tx.PasswordResetToken.
+			Update().
+			Where(
+				passwordresettoken.HasUserWith(user.ID(target.ID)),
+				passwordresettoken.UsedAtIsNil(),
+			).
+			SetUsedAt(now).
+			Save(r.Context())
+		if err != nil {
+			return err
+		}
+		if used == 0 {
+			return errInvalidResetToken
+		}
+
+		// Hashing is slow on purpose, so it only happens once the token is
+		// used up, and invalid tokens can not be used to load the server.
+		hashedPassword, err := h.Passwords.Hash(req.Password)
+		if err != nil {
+			return err
+		}
+		err = tx.User.UpdateOne(target).SetPasswordHash(hashedPassword).Exec(r.Context())
+		if err != nil {
+			return err
+		}
+		return revokeRefreshTokens(r.Context(), tx.Client(), refreshtoken.HasUserWith(user.ID(target.ID)))
+	})
+	if errors.Is(err, errInvalidResetToken) {
+		http.Error(w, "Invalid or expired reset token", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to reset password: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if h.Revocations != nil {
+		if err := h.Revocations.RevokeUser(r.Context(), target.ID); err != nil {
+			log.Printf("Failed to revoke access tokens of %s: %v", target.Username, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	log.Printf("Password reset for user %s", target.Username)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"message": "Password reset successfully"})
+}
+
+// sendPasswordResetEmail stores a new reset token for a user and emails it to them.
+func (h *UserHandler) sendPasswordResetEmail(ctx context.Context, u *ent.User) error {
+	if h.Mailer == nil {
+		return errors.New("no mailer configured")
+	}
+
+	token, hash, err := auth.NewPasswordResetToken()
+	if err != nil {
+		return err
+	}
+	err = h.Database.PasswordResetToken.
+		Create().
+		SetUser(u).
+		SetTokenHash(hash).
+		SetExpiresAt(time.Now().Add(h.PasswordResetTTL)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.Mailer.Send(ctx, mail.Message{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the code below to reset your password:\n\n%s\n\n"+
+			"It can only be used once and expires in %d minutes. If you did not ask to reset your password, you can ignore this email.\n",
+			u.Username, token, int(h.PasswordResetTTL.Minutes())),
+	})
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"game-scores/ent"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	"game-scores/internal/decoder"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SetRoleRequest defines the shape of the request body for changing a user's role.
+type SetRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// RoleResponse defines the shape of a role and the permissions it grants.
+// TwoFactorRequired is set when they are only granted to sessions that logged
+// in with two-factor authentication.
+type RoleResponse struct {
+	Role              string   `json:"role"`
+	Permissions       []string `json:"permissions"`
+	TwoFactorRequired bool     `json:"two_factor_required,omitempty"`
+}
+
+// UserRoleResponse defines the shape of a user's role returned in the response.
+type UserRoleResponse struct {
+	Username string `json:"username"`
+	RoleResponse
+}
+
+// ListRoles returns the permission catalog: every role and the permissions it grants.
+func (h *UserHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	response := make([]RoleResponse, len(auth.Roles))
+	for i, role := range auth.Roles {
+		response[i] = newRoleResponse(role)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// SetUserRole changes the role of a user. Tokens carry the role, so the user's
+// access tokens are revoked and the new role applies from their next refresh.
+func (h *UserHandler) SetUserRole(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	var req SetRoleRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode set role request: %v", err)
+		return
+	}
+
+	role := user.Role(req.Role)
+	if err := user.RoleValidator(role); err != nil {
+		http.Error(w, "Role must be one of \"player\", \"moderator\", \"game_owner\" or \"admin\"", http.StatusBadRequest)
+		return
+	}
+
+	username := chi.URLParam(r, "username")
+
+	// Users could otherwise lock everyone out of managing roles.
+	if validate.UsernameKey(username) == validate.UsernameKey(claims.Username) {
+		http.Error(w, "Users can not change their own role", http.StatusForbidden)
+		return
+	}
+
+	target, err := h.Database.User.
+		Query().
+		Where(user.UsernameKey(validate.UsernameKey(username))).
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to query user %s: %v", username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if target.Role != role {
+		err = h.Database.User.UpdateOne(target).SetRole(role).Exec(r.Context())
+		if err != nil {
+			log.Printf("Failed to change the role of %s: %v", username, err)
+			http.Error(w, "Failed to change role", http.StatusInternalServerError)
+			return
+		}
+		if h.Revocations != nil {
+			if err := h.Revocations.RevokeUser(r.Context(), target.ID); err != nil {
+				log.Printf("Failed to revoke access tokens of %s: %v", username, err)
+				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				return
+			}
+		}
+		log.Printf("User %s changed the role of %s from %s to %s", claims.Username, username, target.Role, role)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(UserRoleResponse{
+		Username:     target.Username,
+		RoleResponse: newRoleResponse(role),
+	})
+}
+
+// newRoleResponse returns a role with the permissions the catalog grants it.
+func newRoleResponse(role user.Role) RoleResponse {
+	permissions := auth.RolePermissions[role]
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return RoleResponse{
+		Role:              string(role),
+		Permissions:       permissions,
+		TwoFactorRequired: auth.RequiresTwoFactor(string(role)),
+	}
+}
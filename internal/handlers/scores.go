@@ -1,45 +1,106 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"math"
+	"net"
 	"net/http"
+	"slices"
 	"strconv"
+	"time"
 
 	"game-scores/ent"
 	"game-scores/ent/game"
+	"game-scores/ent/predicate"
 	"game-scores/ent/score"
 	"game-scores/ent/user"
+	"game-scores/internal/auth"
 	"game-scores/internal/decoder"
+	"game-scores/internal/events"
 	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
 
+	"entgo.io/ent/dialect"
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultScoresPageSize is the number of leaderboard entries returned when no limit is given.
+	DefaultScoresPageSize = 50
+	MaximumScoresPageSize = 500
+	// DefaultRankNeighbours is the number of players shown above and below a player's rank.
+	DefaultRankNeighbours = 5
+	MaximumRankNeighbours = 50
+	// MaximumMetadataEntries limits the client metadata stored with each score submission.
+	MaximumMetadataEntries     = 16
+	MaximumMetadataKeyLength   = 64
+	MaximumMetadataValueLength = 256
 )
 
 // GameScoresHandler holds dependencies for game-related handlers.
 type GameScoresHandler struct {
 	Database *ent.Client
+	// Dialect is the SQL dialect of the database. Statistics are computed by the
+	// database on Postgres, and in Go on the others.
+	Dialect string
+	// Events streams leaderboard changes to spectators, streaming is disabled when nil.
+	Events *events.Broker
 }
 
 // AddGameRequest defines the shape of the request body for adding a score to a game.
+// Metadata is optional free-form data from the game client, kept in the submission history.
+// Username names the player when a game server submits the score with its server key.
 type UpdateScoreRequest struct {
-	Score string `json:"score"`
+	Username string            `json:"username,omitempty"`
+	Score    string            `json:"score"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// JoinGameRequest defines the shape of the request body game servers send to
+// join a player to a game. Players joining themselves send no body.
+type JoinGameRequest struct {
+	Username string `json:"username"`
 }
 
 // GameScoreResponse defines the shape of the scores returned in the response.
 type GameScoreResponse struct {
+	Rank     int    `json:"rank"`
 	Username string `json:"username"`
 	Score    string `json:"score"`
 }
 
-type ScoreUpdateResponse struct {
-	Score string `json:"score"`
+// GameScoresPageResponse defines the shape of a page of a game's leaderboard.
+// NextCursor is omitted on the last page.
+type GameScoresPageResponse struct {
+	Scores     []GameScoreResponse `json:"scores"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
+
+// PlayerRankResponse defines the shape of a player's standing on a game's leaderboard.
+// Percentile is the percentage of participants the player is ahead of, counting ties as half.
+type PlayerRankResponse struct {
+	Username     string              `json:"username"`
+	Score        string              `json:"score"`
+	Rank         int                 `json:"rank"`
+	Percentile   float64             `json:"percentile"`
+	Participants int                 `json:"participants"`
+	Above        []GameScoreResponse `json:"above"`
+	Below        []GameScoreResponse `json:"below"`
 }
 
-type GameStatisticsResponse struct {
-	Mean   string   `json:"mean"`
-	Median string   `json:"median"`
-	Mode   []string `json:"mode"`
+// IncrementScoreRequest defines the shape of the request body for adding to a cumulative score.
+type IncrementScoreRequest struct {
+	Username string            `json:"username,omitempty"`
+	Amount   string            `json:"amount"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+type ScoreUpdateResponse struct {
+	Score string `json:"score"`
 }
 
 // ListGameScores retrieves a game's scores from the database and returns them as a JSON response.
@@ -53,61 +114,260 @@ func (h *GameScoresHandler) ListGameScores(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	exists, err := h.Database.Game.
-		Query().
-		Where(game.ID(gameID)).
-		Exist(r.Context())
-
+	// Look up the game, its sort order decides how the scores are ranked.
+	g, err := h.Database.Game.Get(r.Context(), gameID)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
 		log.Printf("Failed to check for game %d: %v", gameID, err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	if !exists {
-		http.Error(w, "Game not found", http.StatusNotFound)
+	// Scores submitted during the running season make up the current leaderboard.
+	currentSeason, err := activeSeason(r.Context(), h.Database, gameID, time.Now())
+	if err != nil {
+		log.Printf("Failed to find the active season of game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	// Query the database for all scores related to this game ID.
-	scores, err := h.Database.Score.
-		Query().
-		Where(score.HasGameWith(game.ID(gameID))). // Filter scores by the game's ID
-		WithUser().                                // DB Optimization: Eager load the user who made the score
-		Order(ent.Desc(score.FieldValue)).         // Sort scores in descending order by value
-		All(r.Context())
+	board, err := newLeaderboard(g, currentSeason).withWindow(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.writeScoresPage(w, r, board)
+}
+
+// writeScoresPage responds with the page of the leaderboard requested with the
+// "limit" and "cursor" query parameters.
+func (h *GameScoresHandler) writeScoresPage(w http.ResponseWriter, r *http.Request, board leaderboard) {
+	gameID := board.gameID
+
+	limit, err := parseLimit(r.URL.Query().Get("limit"), DefaultScoresPageSize, MaximumScoresPageSize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	query := board.query(h.Database).
+		WithUser().      // DB Optimization: Eager load the user who made the score
+		Limit(limit + 1) // Fetch one extra entry to know if there is a next page
+
+	// Resume after the last entry of the previous page, if a cursor was given.
+	position := 1
+	if c := r.URL.Query().Get("cursor"); c != "" {
+		cursor, err := decodeScoreCursor(c)
+		if err != nil {
+			http.Error(w, "Invalid cursor", http.StatusBadRequest)
+			return
+		}
+
+		before, err := board.count(r.Context(), h.Database, board.before(cursor.Value, cursor.ID))
+		if err != nil {
+			log.Printf("Failed to count scores for game %d: %v", gameID, err)
+			http.Error(w, "Failed to retrieve scores", http.StatusInternalServerError)
+			return
+		}
+		position = before + 2 // The cursor entry itself is not part of the page
+		query = query.Where(board.after(cursor.Value, cursor.ID))
+	}
 
+	scores, err := query.All(r.Context())
 	if err != nil {
 		log.Printf("Failed to retrieve scores for game %d: %v", gameID, err)
 		http.Error(w, "Failed to retrieve scores", http.StatusInternalServerError)
 		return
 	}
 
-	// Add the scores to the response.
-	scoreResponses := make([]GameScoreResponse, len(scores))
-	for i, s := range scores {
-		scoreResponses[i] = GameScoreResponse{
-			Username: s.Edges.User.Username,
-			Score:    strconv.FormatInt(s.Value, 10), // Convert int64 score to string
-		}
+	var response GameScoresPageResponse
+	if len(scores) > limit {
+		scores = scores[:limit]
+		response.NextCursor = encodeScoreCursor(scores[limit-1])
+	}
+
+	// Add the ranked scores to the response.
+	response.Scores, err = board.entries(r.Context(), h.Database, scores, position)
+	if err != nil {
+		log.Printf("Failed to rank scores for game %d: %v", gameID, err)
+		http.Error(w, "Failed to retrieve scores", http.StatusInternalServerError)
+		return
 	}
 
 	// Send the response.
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(scoreResponses)
+	json.NewEncoder(w).Encode(response)
 }
 
-// JoinGame creates an initial score of 0 for the logged-in user and a specific game.
-func (h *GameScoresHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
-	// 1. Get the User ID from the JWT claims.
+// GetMyRank returns the logged-in player's standing on a game's leaderboard.
+func (h *GameScoresHandler) GetMyRank(w http.ResponseWriter, r *http.Request) {
 	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
 	if !ok {
 		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
 		return
 	}
-	userID := claims.UserID
 
-	// 2. Decode the Game ID from the request body.
+	h.writePlayerRank(w, r, user.ID(claims.UserID))
+}
+
+// GetPlayerRank returns the standing of the player named in the URL on a game's leaderboard.
+func (h *GameScoresHandler) GetPlayerRank(w http.ResponseWriter, r *http.Request) {
+	h.writePlayerRank(w, r, user.UsernameKey(validate.UsernameKey(chi.URLParam(r, "username"))))
+}
+
+// writePlayerRank finds the score of the player matching the predicate and responds
+// with their rank, percentile and the players directly around them.
+func (h *GameScoresHandler) writePlayerRank(w http.ResponseWriter, r *http.Request, player predicate.User) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	neighbours := DefaultRankNeighbours
+	if raw := r.URL.Query().Get("neighbours"); raw != "" {
+		neighbours, err = strconv.Atoi(raw)
+		if err != nil || neighbours < 0 || neighbours > MaximumRankNeighbours {
+			http.Error(w, "neighbours must be a number between 0 and "+strconv.Itoa(MaximumRankNeighbours), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Look up the game, its sort order decides how the scores are ranked.
+	g, err := h.Database.Game.Get(r.Context(), gameID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to check for game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Scores submitted during the running season make up the current leaderboard.
+	currentSeason, err := activeSeason(r.Context(), h.Database, gameID, time.Now())
+	if err != nil {
+		log.Printf("Failed to find the active season of game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	board, err := newLeaderboard(g, currentSeason).withWindow(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Find the player's own entry on the leaderboard.
+	playerScore, err := board.query(h.Database).
+		Where(score.HasUserWith(player)).
+		WithUser().
+		Only(r.Context())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Score not found, player has no score in this game.", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to find player score for game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	rank, err := h.rankPlayer(r.Context(), board, playerScore, neighbours)
+	if err != nil {
+		log.Printf("Failed to rank player %s in game %d: %v", playerScore.Edges.User.Username, gameID, err)
+		http.Error(w, "Failed to retrieve rank", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rank)
+}
+
+// rankPlayer computes the standing of a score on the leaderboard along with up to
+// neighbours entries directly above and below it.
+func (h *GameScoresHandler) rankPlayer(ctx context.Context, board leaderboard, playerScore *ent.Score, neighbours int) (*PlayerRankResponse, error) {
+	participants, err := board.count(ctx, h.Database)
+	if err != nil {
+		return nil, err
+	}
+	better, err := board.count(ctx, h.Database, board.better(playerScore.Value))
+	if err != nil {
+		return nil, err
+	}
+	equal, err := board.count(ctx, h.Database, score.ValueEQ(playerScore.Value))
+	if err != nil {
+		return nil, err
+	}
+	before, err := board.count(ctx, h.Database, board.before(playerScore.Value, playerScore.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Ties count as half, excluding the player themselves.
+	worse := participants - better - equal
+	percentile := 100 * (float64(worse) + float64(equal-1)/2) / float64(participants)
+
+	response := &PlayerRankResponse{
+		Username:     playerScore.Edges.User.Username,
+		Score:        strconv.FormatInt(playerScore.Value, 10),
+		Rank:         better + 1,
+		Percentile:   math.Round(percentile*100) / 100,
+		Participants: participants,
+		Above:        []GameScoreResponse{},
+		Below:        []GameScoreResponse{},
+	}
+
+	if neighbours == 0 {
+		return response, nil
+	}
+
+	// The entries above are fetched closest first, so they are flipped back into ranking order.
+	above, err := board.reversed(h.Database).
+		Where(board.before(playerScore.Value, playerScore.ID)).
+		WithUser().
+		Limit(neighbours).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(above)
+
+	response.Above, err = board.entries(ctx, h.Database, above, before+1-len(above))
+	if err != nil {
+		return nil, err
+	}
+
+	below, err := board.query(h.Database).
+		Where(board.after(playerScore.Value, playerScore.ID)).
+		WithUser().
+		Limit(neighbours).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Below, err = board.entries(ctx, h.Database, below, before+2)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// JoinGame creates an initial score of 0 for the logged-in user and a specific game.
+// Game servers join the player named in the request body instead.
+func (h *GameScoresHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
+	// 1. Decode the Game ID from the URL.
 	gameIDStr := chi.URLParam(r, "gameID")
 	gameID, err := strconv.Atoi(gameIDStr)
 	if err != nil {
@@ -115,12 +375,40 @@ func (h *GameScoresHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 2. Find the player joining, game servers name them in the request body.
+	var req JoinGameRequest
+	if _, isServer := auth_middleware.ServerKeyFromContext(r.Context()); isServer {
+		err = decoder.DecodeJSONBody(w, r, &req)
+		if err != nil {
+			log.Printf("Failed to decode join game request: %v", err)
+			return
+		}
+	}
+
+	userID, username, ok := h.scorePlayer(w, r, gameID, req.Username)
+	if !ok {
+		return
+	}
+
+	// Players join the running season, if there is one.
+	currentSeason, err := activeSeason(r.Context(), h.Database, gameID, time.Now())
+	if err != nil {
+		log.Printf("Failed to find the active season of game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	var currentSeasonID *int
+	if currentSeason != nil {
+		currentSeasonID = &currentSeason.ID
+	}
+
 	// 3. Check if the user has already joined this game to prevent duplicates.
 	exists, err := h.Database.Score.
 		Query().
 		Where(
 			score.HasUserWith(user.ID(userID)),
 			score.HasGameWith(game.ID(gameID)),
+			seasonPredicate(seasonIDOf(currentSeason)),
 		).
 		Exist(r.Context())
 
@@ -140,14 +428,30 @@ func (h *GameScoresHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
 		Create().
 		SetUserID(userID).
 		SetGameID(gameID).
+		SetNillableSeasonID(currentSeasonID).
 		Save(r.Context())
 
+	if ent.IsConstraintError(err) {
+		http.Error(w, "User has already joined this game", http.StatusConflict)
+		return
+	}
 	if err != nil {
 		log.Printf("Failed to create score (join game): %v", err)
 		http.Error(w, "Failed to join game", http.StatusInternalServerError)
 		return
 	}
 
+	// Announce the new player on the game's stream. On lower-is-better games
+	// players are only ranked once they submit a score.
+	if h.Events != nil {
+		g, err := newScore.QueryGame().Only(r.Context())
+		if err != nil {
+			log.Printf("Failed to load game %d for its stream: %v", gameID, err)
+		} else if board := newLeaderboard(g, currentSeason); !board.ascending {
+			h.publishScore(r.Context(), board, ScoreEventJoin, username, newScore)
+		}
+	}
+
 	// Respond with a success message.
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(map[string]any{
@@ -157,16 +461,10 @@ func (h *GameScoresHandler) JoinGame(w http.ResponseWriter, r *http.Request) {
 }
 
 // UpdateGameScore handles updating a user's score for a specific game.
+// The game's score policy decides whether the new score replaces the current
+// one when it is better, always replaces it, or is added to it.
 func (h *GameScoresHandler) UpdateGameScore(w http.ResponseWriter, r *http.Request) {
 
-	// Get the user ID from the JWT
-	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
-	if !ok {
-		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
-		return
-	}
-	userID := claims.UserID
-
 	// Get the Game ID from the URL
 	gameIDStr := chi.URLParam(r, "gameID")
 	gameID, err := strconv.Atoi(gameIDStr)
@@ -184,15 +482,14 @@ func (h *GameScoresHandler) UpdateGameScore(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Find the current game score of the player
-	scoreToUpdate, err := h.Database.Score.
-		Query().
-		Where(
-			score.HasUserWith(user.ID(userID)),
-			score.HasGameWith(game.ID(gameID)),
-		).
-		Only(r.Context())
+	// Get the player the score is for, from the JWT or the request of a game server
+	userID, _, ok := h.scorePlayer(w, r, gameID, req.Username)
+	if !ok {
+		return
+	}
 
+	// Find the current game score of the player
+	scoreToUpdate, err := h.findPlayerScore(r.Context(), userID, gameID)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			http.Error(w, "Score not found, player must join the game first.", http.StatusNotFound)
@@ -210,23 +507,39 @@ func (h *GameScoresHandler) UpdateGameScore(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// Check if the new score is greater than the current score
-	if newScore < scoreToUpdate.Value {
-		http.Error(w, "New score is less than the current one, UNACCEPTABLE!", http.StatusNotAcceptable)
+	if newScore < 0 {
+		http.Error(w, "Score must not be negative", http.StatusBadRequest)
 		return
 	}
 
-	// Update the score with the new value.
-	updatedScore, err := scoreToUpdate.Update().
-		SetValue(newScore).
-		Save(r.Context())
+	if err := validateMetadata(req.Metadata); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
+	updatedScore, accepted, err := h.applySubmission(r, scoreToUpdate, newScore, req.Metadata)
 	if err != nil {
 		log.Printf("Failed to update score: %v", err)
 		http.Error(w, "Failed to update score", http.StatusInternalServerError)
 		return
 	}
 
+	if !accepted {
+		if scoreToUpdate.Edges.Game.ScorePolicy == game.ScorePolicyCumulative {
+			http.Error(w, "Score would exceed the largest possible score", http.StatusConflict)
+			return
+		}
+		if scoreToUpdate.Edges.Game.SortOrder == game.SortOrderAscending {
+			http.Error(w, "New score is greater than the current one, UNACCEPTABLE!", http.StatusNotAcceptable)
+			return
+		}
+		http.Error(w, "New score is less than the current one, UNACCEPTABLE!", http.StatusNotAcceptable)
+		return
+	}
+
+	board := newLeaderboard(scoreToUpdate.Edges.Game, scoreToUpdate.Edges.Season)
+	h.publishScore(r.Context(), board, ScoreEventUpdate, scoreToUpdate.Edges.User.Username, updatedScore)
+
 	// 6. Respond with the updated score.
 	response := ScoreUpdateResponse{
 		Score: strconv.FormatInt(updatedScore.Value, 10),
@@ -236,10 +549,11 @@ func (h *GameScoresHandler) UpdateGameScore(w http.ResponseWriter, r *http.Reque
 	json.NewEncoder(w).Encode(response)
 }
 
-// ListGameScores retrieves a game's scores from the database and returns them as a JSON response.
-func (h *GameScoresHandler) ListGameScoreStatistics(w http.ResponseWriter, r *http.Request) {
+// IncrementGameScore atomically adds an amount to the user's score on a game
+// with the cumulative score policy.
+func (h *GameScoresHandler) IncrementGameScore(w http.ResponseWriter, r *http.Request) {
 
-	// Get the game ID from the URL parameter.
+	// Get the Game ID from the URL
 	gameIDStr := chi.URLParam(r, "gameID")
 	gameID, err := strconv.Atoi(gameIDStr)
 	if err != nil {
@@ -247,107 +561,340 @@ func (h *GameScoresHandler) ListGameScoreStatistics(w http.ResponseWriter, r *ht
 		return
 	}
 
-	exists, err := h.Database.Game.
-		Query().
-		Where(game.ID(gameID)).
-		Exist(r.Context())
+	var req IncrementScoreRequest
 
+	err = decoder.DecodeJSONBody(w, r, &req)
 	if err != nil {
-		log.Printf("Failed to check for game %d: %v", gameID, err)
+		log.Printf("Failed to decode increment score request: %v", err)
+		return
+	}
+
+	// Get the player the score is for, from the JWT or the request of a game server
+	userID, _, ok := h.scorePlayer(w, r, gameID, req.Username)
+	if !ok {
+		return
+	}
+
+	amount, err := strconv.ParseInt(req.Amount, 10, 64)
+	if err != nil || amount <= 0 {
+		http.Error(w, "Amount must be a positive number", http.StatusBadRequest)
+		return
+	}
+
+	if err := validateMetadata(req.Metadata); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Find the current game score of the player
+	scoreToUpdate, err := h.findPlayerScore(r.Context(), userID, gameID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Score not found, player must join the game first.", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to find score to increment: %v", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
-	if !exists {
-		http.Error(w, "Game not found", http.StatusNotFound)
+	if scoreToUpdate.Edges.Game.ScorePolicy != game.ScorePolicyCumulative {
+		http.Error(w, "Scores of this game can not be incremented, its score policy is not cumulative", http.StatusConflict)
+		return
+	}
+
+	updatedScore, accepted, err := h.applySubmission(r, scoreToUpdate, amount, req.Metadata)
+	if err != nil {
+		log.Printf("Failed to increment score: %v", err)
+		http.Error(w, "Failed to increment score", http.StatusInternalServerError)
+		return
+	}
+
+	if !accepted {
+		http.Error(w, "Score would exceed the largest possible score", http.StatusConflict)
 		return
 	}
 
-	// Query the database for all scores related to this game ID.
-	scores, err := h.Database.Score.
+	board := newLeaderboard(scoreToUpdate.Edges.Game, scoreToUpdate.Edges.Season)
+	h.publishScore(r.Context(), board, ScoreEventUpdate, scoreToUpdate.Edges.User.Username, updatedScore)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ScoreUpdateResponse{
+		Score: strconv.FormatInt(updatedScore.Value, 10),
+	})
+}
+
+// scorePlayer returns the ID and username of the player a score request is for.
+// Players can only play for themselves, game servers play for the player named
+// in the request, if their server key can submit scores to the game. When the
+// request is not allowed the error is written and false is returned.
+func (h *GameScoresHandler) scorePlayer(w http.ResponseWriter, r *http.Request, gameID int, username string) (uuid.UUID, string, bool) {
+	if key, isServer := auth_middleware.ServerKeyFromContext(r.Context()); isServer {
+		if !auth.ServerKeyAllows(key, gameID, auth.PermissionSubmitScores) {
+			http.Error(w, "Forbidden: This API key can not submit scores to this game", http.StatusForbidden)
+			return uuid.Nil, "", false
+		}
+		if username == "" {
+			http.Error(w, "Username is required when submitting with an API key", http.StatusBadRequest)
+			return uuid.Nil, "", false
+		}
+
+		player, err := h.Database.User.
+			Query().
+			Where(user.UsernameKey(validate.UsernameKey(username))).
+			Only(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				http.Error(w, "User not found", http.StatusNotFound)
+				return uuid.Nil, "", false
+			}
+			log.Printf("Failed to query user %s: %v", username, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return uuid.Nil, "", false
+		}
+		return player.ID, player.Username, true
+	}
+
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return uuid.Nil, "", false
+	}
+	if username != "" && username != claims.Username {
+		http.Error(w, "Forbidden: Players can only submit their own scores", http.StatusForbidden)
+		return uuid.Nil, "", false
+	}
+	return claims.UserID, claims.Username, true
+}
+
+// findPlayerScore returns a user's score on a game in the running season, with
+// its game, user and season edges loaded. Players who joined the game before carry over
+// into each new season, starting from zero.
+func (h *GameScoresHandler) findPlayerScore(ctx context.Context, userID uuid.UUID, gameID int) (*ent.Score, error) {
+	currentSeason, err := activeSeason(ctx, h.Database, gameID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	query := h.Database.Score.
+		Query().
+		Where(
+			score.HasUserWith(user.ID(userID)),
+			score.HasGameWith(game.ID(gameID)),
+			seasonPredicate(seasonIDOf(currentSeason)),
+		).
+		WithGame(). // The game's policies decide how the score changes
+		WithUser().
+		WithSeason()
+
+	playerScore, notFoundErr := query.Clone().Only(ctx)
+	if !ent.IsNotFound(notFoundErr) || currentSeason == nil {
+		return playerScore, notFoundErr
+	}
+
+	// Check if the player joined the game in an earlier season or before seasons began.
+	joined, err := h.Database.Score.
 		Query().
-		Where(score.HasGameWith(game.ID(gameID))). // Filter scores by the game's ID
-		Order(ent.Desc(score.FieldValue)).         // Sort scores in descending order by value
-		All(r.Context())
+		Where(
+			score.HasUserWith(user.ID(userID)),
+			score.HasGameWith(game.ID(gameID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !joined {
+		return nil, notFoundErr
+	}
+
+	err = h.Database.Score.
+		Create().
+		SetUserID(userID).
+		SetGameID(gameID).
+		SetSeason(currentSeason).
+		Exec(ctx)
+	// A concurrent request may have carried the player over first.
+	if err != nil && !ent.IsConstraintError(err) {
+		return nil, err
+	}
+
+	return query.Only(ctx)
+}
 
+// applySubmission records a submitted value in the player's history and applies
+// it to their score according to the game's score policy. It returns the
+// resulting score and whether the submission changed it.
+//
+// The score is changed with a single conditional UPDATE, so concurrent
+// submissions from the same player can not overwrite each other's results.
+func (h *GameScoresHandler) applySubmission(r *http.Request, current *ent.Score, value int64, metadata map[string]string) (*ent.Score, bool, error) {
+	ctx := r.Context()
+	g := current.Edges.Game
+	submittedAt := time.Now()
+
+	updated := current
+	accepted := false
+
+	// Submissions of game servers remember the key they were made with.
+	var serverKeyID *int
+	if key, isServer := auth_middleware.ServerKeyFromContext(ctx); isServer {
+		serverKeyID = &key.ID
+	}
+
+	// Record the attempt and update the score together, so the history always
+	// agrees with the current score.
+	err := withTx(ctx, h.Database, func(tx *ent.Tx) error {
+		update := tx.Score.
+			Update().
+			Where(score.ID(current.ID)).
+			SetAchievedAt(submittedAt)
+
+		switch g.ScorePolicy {
+		case game.ScorePolicyCumulative:
+			// Submitted values are never negative, so only the sum can overflow.
+			update.AddValue(value).Where(score.ValueLTE(math.MaxInt64 - value))
+		case game.ScorePolicyLatest:
+			update.SetValue(value)
+		default:
+			// Only replace the score if the new value is at least as good.
+			update.SetValue(value).Where(newLeaderboard(g, nil).replaceableBy(value))
+		}
+
+		changed, err := update.Save(ctx)
+		if err != nil {
+			return err
+		}
+		accepted = changed > 0
+
+		err = tx.ScoreSubmission.
+			Create().
+			SetUserID(current.Edges.User.ID).
+			SetGameID(g.ID).
+			SetScoreID(current.ID).
+			SetValue(value).
+			SetAccepted(accepted).
+			SetSubmittedAt(submittedAt).
+			SetClientIP(clientIP(r)).
+			SetUserAgent(r.UserAgent()).
+			SetMetadata(metadata).
+			SetNillableServerKeyID(serverKeyID).
+			Exec(ctx)
+		if err != nil || !accepted {
+			return err
+		}
+
+		updated, err = tx.Score.Get(ctx, current.ID)
+		return err
+	})
 	if err != nil {
-		log.Printf("Failed to retrieve scores for game %d: %v", gameID, err)
-		http.Error(w, "Failed to retrieve scores", http.StatusInternalServerError)
+		return nil, false, err
+	}
+
+	return updated, accepted, nil
+}
+
+// ListGameScoreStatistics retrieves a game's scores from the database and returns their statistics as a JSON response.
+func (h *GameScoresHandler) ListGameScoreStatistics(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
 		return
 	}
 
-	scoresArray := make([]int64, len(scores))
-	for i, s := range scores {
-		scoresArray[i] = s.Value // Collect all scores in an array
+	// Look up the game, its sort order decides how the scores are ranked.
+	g, err := h.Database.Game.Get(r.Context(), gameID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Game not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to check for game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
 	}
 
-	var mean, median int64
-	var mode []int64
+	// Scores submitted during the running season make up the current leaderboard.
+	currentSeason, err := activeSeason(r.Context(), h.Database, gameID, time.Now())
+	if err != nil {
+		log.Printf("Failed to find the active season of game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
 
-	if len(scoresArray) == 0 {
-		mean = 0
-		median = 0
-		mode = []int64{0}
-	} else {
-		calculateMean(scoresArray, &mean)
-		calculateMedian(scoresArray, &median) // Scores are already sorted in descending order, provided by the query
-		calculateMode(scoresArray, &mode)
+	board, err := newLeaderboard(g, currentSeason).withWindow(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	// Add the scores statistics to the response.
-	scoreStatistics := GameStatisticsResponse{
-		Mean:   strconv.FormatInt(mean, 10),
-		Median: strconv.FormatInt(median, 10),
-		Mode:   make([]string, len(mode)),
+	histogram, err := parseHistogramSpec(r.URL.Query().Get("buckets"), r.URL.Query().Get("edges"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	for i, m := range mode {
-		scoreStatistics.Mode[i] = strconv.FormatInt(m, 10)
+	summary, err := h.summarize(r.Context(), board, histogram)
+	if err != nil {
+		log.Printf("Failed to compute statistics for game %d: %v", gameID, err)
+		http.Error(w, "Failed to retrieve scores", http.StatusInternalServerError)
+		return
 	}
 
+	// Add the scores statistics to the response.
+	scoreStatistics := summary.response()
+
 	// Send the response.
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(scoreStatistics)
 }
 
-// calculateMean, calculateMedian, and calculateMode are utility functions to compute statistics on scores.
-// They are used to calculate the mean, median, and mode of a slice of int64 scores.
-// They assume that scores are non-empty
+// summarize computes the summary of the scores on a leaderboard. On Postgres the
+// database aggregates them, other drivers lack the functions needed, so the
+// scores are loaded and summarized in Go.
+func (h *GameScoresHandler) summarize(ctx context.Context, board leaderboard, histogram histogramSpec) (scoreSummary, error) {
+	if h.Dialect == dialect.Postgres {
+		return summarizeInDatabase(ctx, h.Database, board, histogram)
+	}
 
-func calculateMean(scores []int64, mean *int64) {
-	sum := int64(0)
-	for _, score := range scores {
-		sum += score
+	scores, err := board.query(h.Database).All(ctx)
+	if err != nil {
+		return scoreSummary{}, err
 	}
-	*mean = sum / int64(len(scores))
-}
 
-// calculateMedian assumes that the scores are sorted
-func calculateMedian(scores []int64, median *int64) {
-	mid := len(scores) / 2
-	if len(scores)%2 == 0 {
-		*median = (scores[mid-1] + scores[mid]) / 2
-	} else {
-		*median = scores[mid]
+	scoresArray := make([]int64, len(scores))
+	for i, s := range scores {
+		scoresArray[i] = s.Value // Collect all scores in an array
 	}
+	slices.Sort(scoresArray) // Statistics are computed on ascending scores, whatever the game's sort order
+
+	return summarizeScores(scoresArray, histogram), nil
 }
 
-func calculateMode(scores []int64, mode *[]int64) {
-	maxFreq := 0
-	frequency := make(map[int64]int)
-	for _, score := range scores {
-		frequency[score]++
-		if frequency[score] > maxFreq {
-			maxFreq = frequency[score]
-		}
-	}
-	if maxFreq == 1 {
-		*mode = []int64{} // No mode if all scores are unique
-		return
+// validateMetadata checks that the client metadata of a score submission is within limits.
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > MaximumMetadataEntries {
+		return fmt.Errorf("Metadata must not have more than %d entries", MaximumMetadataEntries)
 	}
-	for score, freq := range frequency {
-		if freq == maxFreq {
-			*mode = append(*mode, score)
+	for key, value := range metadata {
+		if key == "" || len(key) > MaximumMetadataKeyLength {
+			return fmt.Errorf("Metadata keys must be between 1 and %d characters", MaximumMetadataKeyLength)
 		}
+		if len(value) > MaximumMetadataValueLength {
+			return fmt.Errorf("Metadata values must not exceed %d characters", MaximumMetadataValueLength)
+		}
+	}
+	return nil
+}
+
+// clientIP returns the address the request came from, without the port. Behind
+// a trusted proxy, it is the client's, see api_middleware.RealIP.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
 	}
+	return host
 }
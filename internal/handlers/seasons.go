@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/season"
+	"game-scores/internal/decoder"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/go-chi/chi/v5"
+)
+
+// SeasonHandler holds dependencies for season-related handlers.
+type SeasonHandler struct {
+	Database *ent.Client
+}
+
+// AddSeasonRequest defines the shape of the request body for adding a season to a game.
+type AddSeasonRequest struct {
+	Name     string    `json:"name"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// SeasonResponse defines the shape of the seasons returned in the response.
+// Status is one of "upcoming", "active" or "ended".
+type SeasonResponse struct {
+	ID       int       `json:"id"`
+	Name     string    `json:"name"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+	Status   string    `json:"status"`
+}
+
+var (
+	// errGameNotFound is returned when the game of a request does not exist.
+	errGameNotFound = errors.New("game not found")
+	// errSeasonOverlaps is returned when a new season overlaps with an existing
+	// season of its game.
+	errSeasonOverlaps = errors.New("season overlaps with an existing season")
+)
+
+// AddSeason handles the addition of a new season to a game.
+func (h *SeasonHandler) AddSeason(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	var req AddSeasonRequest
+
+	err = decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode add season request: %v", err)
+		return
+	}
+
+	if req.Name == "" {
+		http.Error(w, "Season name cannot be empty", http.StatusBadRequest)
+		return
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		http.Error(w, "Season must end after it starts", http.StatusBadRequest)
+		return
+	}
+
+	// Scores are assigned to the season running when they are submitted, so
+	// seasons of the same game can not overlap. The game is locked until the
+	// season is created, so concurrent requests can not both pass the check.
+	var newSeason *ent.Season
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		locked, err := tx.Game.
+			Query().
+			Where(game.ID(gameID)).
+			Select(game.FieldID).
+			Modify(func(s *entsql.Selector) { s.ForUpdate() }).
+			Ints(r.Context())
+		if err != nil {
+			return err
+		}
+		if len(locked) == 0 {
+			return errGameNotFound
+		}
+
+		overlaps, err := tx.Season.
+			Query().
+			Where(
+				season.HasGameWith(game.ID(gameID)),
+				season.StartsAtLT(req.EndsAt),
+				season.EndsAtGT(req.StartsAt),
+			).
+			Exist(r.Context())
+		if err != nil {
+			return err
+		}
+		if overlaps {
+			return errSeasonOverlaps
+		}
+
+		newSeason, err = tx.Season.
+			Create().
+			SetName(req.Name).
+			SetStartsAt(req.StartsAt).
+			SetEndsAt(req.EndsAt).
+			SetGameID(gameID).
+			Save(r.Context())
+		return err
+	})
+	switch {
+	case errors.Is(err, errGameNotFound):
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	case errors.Is(err, errSeasonOverlaps):
+		http.Error(w, "Season overlaps with an existing season of this game", http.StatusConflict)
+		return
+	case err != nil:
+		log.Printf("Failed to create season for game %d: %v", gameID, err)
+		http.Error(w, "Failed to create season", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("Season added successfully: %s, ID: %d, game ID: %d", newSeason.Name, newSeason.ID, gameID)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(newSeasonResponse(newSeason, time.Now()))
+}
+
+// ListSeasons retrieves all the seasons of a game, oldest first.
+func (h *SeasonHandler) ListSeasons(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	seasons, err := h.Database.Season.
+		Query().
+		Where(season.HasGameWith(game.ID(gameID))).
+		Order(ent.Asc(season.FieldStartsAt)).
+		All(r.Context())
+
+	if err != nil {
+		log.Printf("Failed to retrieve seasons for game %d: %v", gameID, err)
+		http.Error(w, "Failed to retrieve seasons", http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	seasonResponses := make([]SeasonResponse, len(seasons))
+	for i, s := range seasons {
+		seasonResponses[i] = newSeasonResponse(s, now)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(seasonResponses)
+}
+
+// ListSeasonScores retrieves the leaderboard of a single season of a game. The
+// leaderboards of ended seasons are frozen.
+func (h *GameScoresHandler) ListSeasonScores(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game and season IDs from the URL parameters.
+	gameID, err := strconv.Atoi(chi.URLParam(r, "gameID"))
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+	seasonID, err := strconv.Atoi(chi.URLParam(r, "seasonID"))
+	if err != nil {
+		http.Error(w, "Invalid season ID format", http.StatusBadRequest)
+		return
+	}
+
+	s, err := h.Database.Season.
+		Query().
+		Where(
+			season.ID(seasonID),
+			season.HasGameWith(game.ID(gameID)),
+		).
+		WithGame().
+		Only(r.Context())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Season not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to check for season %d of game %d: %v", seasonID, gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	board, err := newLeaderboard(s.Edges.Game, s).withWindow(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.writeScoresPage(w, r, board)
+}
+
+// activeSeason returns the season of a game running at the given time, or nil
+// if the game is between seasons.
+func activeSeason(ctx context.Context, client *ent.Client, gameID int, now time.Time) (*ent.Season, error) {
+	s, err := client.Season.
+		Query().
+		Where(
+			season.HasGameWith(game.ID(gameID)),
+			season.StartsAtLTE(now),
+			season.EndsAtGT(now),
+		).
+		Order(ent.Asc(season.FieldStartsAt)).
+		First(ctx)
+
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return s, err
+}
+
+// seasonIDOf returns the ID of a season, or 0 for no season.
+func seasonIDOf(s *ent.Season) int {
+	if s == nil {
+		return 0
+	}
+	return s.ID
+}
+
+// newSeasonResponse converts a season to its response, with its status at the given time.
+func newSeasonResponse(s *ent.Season, now time.Time) SeasonResponse {
+	status := "active"
+	switch {
+	case now.Before(s.StartsAt):
+		status = "upcoming"
+	case !now.Before(s.EndsAt):
+		status = "ended"
+	}
+
+	return SeasonResponse{
+		ID:       s.ID,
+		Name:     s.Name,
+		StartsAt: s.StartsAt,
+		EndsAt:   s.EndsAt,
+		Status:   status,
+	}
+}
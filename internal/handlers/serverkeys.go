@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/serverkey"
+	"game-scores/internal/auth"
+	"game-scores/internal/decoder"
+	auth_middleware "game-scores/internal/middleware"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ServerKeyHandler holds dependencies for the handlers managing game-server API keys.
+type ServerKeyHandler struct {
+	Database *ent.Client
+}
+
+// AddServerKeyRequest defines the shape of the request body for creating a server key.
+type AddServerKeyRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// ServerKeyResponse defines the shape of the server keys returned in the response.
+// Key is only set when the key is created, it can not be retrieved afterwards.
+type ServerKeyResponse struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Key         string     `json:"key,omitempty"`
+	Prefix      string     `json:"prefix"`
+	Permissions []string   `json:"permissions"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+}
+
+// AddServerKey creates an API key for the dedicated servers of a game.
+func (h *ServerKeyHandler) AddServerKey(w http.ResponseWriter, r *http.Request) {
+
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	var req AddServerKeyRequest
+
+	err = decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode add server key request: %v", err)
+		return
+	}
+
+	if req.Name == "" {
+		http.Error(w, "Server key name cannot be empty", http.StatusBadRequest)
+		return
+	}
+	if len(req.Permissions) == 0 {
+		http.Error(w, "Server key must have at least one permission", http.StatusBadRequest)
+		return
+	}
+	for _, permission := range req.Permissions {
+		if !auth.ValidServerKeyPermission(permission) {
+			http.Error(w, fmt.Sprintf("Unknown permission %q, must be one of: %s", permission, strings.Join(auth.ServerKeyPermissions, ", ")), http.StatusBadRequest)
+			return
+		}
+	}
+
+	exists, err := h.Database.Game.
+		Query().
+		Where(game.ID(gameID)).
+		Exist(r.Context())
+
+	if err != nil {
+		log.Printf("Failed to check for game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if !exists {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		log.Printf("Failed to generate server key: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	newKey, err := h.Database.ServerKey.
+		Create().
+		SetName(req.Name).
+		SetKeyHash(hash).
+		SetPrefix(prefix).
+		SetPermissions(req.Permissions).
+		SetGameID(gameID).
+		Save(r.Context())
+
+	if err != nil {
+		log.Printf("Failed to create server key: %v", err)
+		http.Error(w, "Failed to create server key", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s created server key %d (%s) for game %d", claims.Username, newKey.ID, newKey.Prefix, gameID)
+	response := newServerKeyResponse(newKey)
+	response.Key = key
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListServerKeys retrieves the server keys of a game, including the revoked ones.
+func (h *ServerKeyHandler) ListServerKeys(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	keys, err := h.Database.ServerKey.
+		Query().
+		Where(serverkey.HasGameWith(game.ID(gameID))).
+		Order(ent.Asc(serverkey.FieldID)).
+		All(r.Context())
+
+	if err != nil {
+		log.Printf("Failed to retrieve server keys for game %d: %v", gameID, err)
+		http.Error(w, "Failed to retrieve server keys", http.StatusInternalServerError)
+		return
+	}
+
+	keyResponses := make([]ServerKeyResponse, len(keys))
+	for i, k := range keys {
+		keyResponses[i] = newServerKeyResponse(k)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(keyResponses)
+}
+
+// RevokeServerKey revokes a server key of a game, servers using it are rejected
+// from then on.
+func (h *ServerKeyHandler) RevokeServerKey(w http.ResponseWriter, r *http.Request) {
+
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	// Get the game and key IDs from the URL parameters.
+	gameID, err := strconv.Atoi(chi.URLParam(r, "gameID"))
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+	keyID, err := strconv.Atoi(chi.URLParam(r, "keyID"))
+	if err != nil {
+		http.Error(w, "Invalid server key ID format", http.StatusBadRequest)
+		return
+	}
+
+	key, err := h.Database.ServerKey.
+		Query().
+		Where(
+			serverkey.ID(keyID),
+			serverkey.HasGameWith(game.ID(gameID)),
+		).
+		Only(r.Context())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Server key not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to query server key %d: %v", keyID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Revoking a key twice keeps the time it was first revoked.
+	if key.RevokedAt == nil {
+		err = h.Database.ServerKey.UpdateOne(key).SetRevokedAt(time.Now()).Exec(r.Context())
+		if err != nil {
+			log.Printf("Failed to revoke server key %d: %v", keyID, err)
+			http.Error(w, "Failed to revoke server key", http.StatusInternalServerError)
+			return
+		}
+		log.Printf("User %s revoked server key %d (%s) of game %d", claims.Username, key.ID, key.Prefix, gameID)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// newServerKeyResponse converts a server key to its response, without the key itself.
+func newServerKeyResponse(k *ent.ServerKey) ServerKeyResponse {
+	return ServerKeyResponse{
+		ID:          k.ID,
+		Name:        k.Name,
+		Prefix:      k.Prefix,
+		Permissions: k.Permissions,
+		CreatedAt:   k.CreatedAt,
+		LastUsedAt:  k.LastUsedAt,
+		RevokedAt:   k.RevokedAt,
+	}
+}
@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultHistogramBuckets is the number of equal-width histogram buckets used when no edges are given.
+	DefaultHistogramBuckets = 10
+	MaximumHistogramBuckets = 100
+	// StatisticsDecimalPlaces is the number of decimals non-integer statistics are rounded to.
+	StatisticsDecimalPlaces = 6
+)
+
+// reportedPercentiles are the percentiles included in the statistics, besides the median.
+var reportedPercentiles = []int64{25, 75, 90, 99}
+
+// GameStatisticsResponse defines the shape of a game's score statistics.
+// All values are decimal strings, so they are exact for any score.
+type GameStatisticsResponse struct {
+	Players   int               `json:"players"`
+	Mean      string            `json:"mean"`
+	Median    string            `json:"median"`
+	Mode      []string          `json:"mode"`
+	Min       string            `json:"min"`
+	Max       string            `json:"max"`
+	P25       string            `json:"p25"`
+	P75       string            `json:"p75"`
+	P90       string            `json:"p90"`
+	P99       string            `json:"p99"`
+	Variance  string            `json:"variance"`
+	StdDev    string            `json:"std_dev"`
+	Histogram []HistogramBucket `json:"histogram"`
+}
+
+// HistogramBucket counts the scores between Lower (inclusive) and Upper
+// (exclusive, inclusive for the last bucket).
+type HistogramBucket struct {
+	Lower string `json:"lower"`
+	Upper string `json:"upper"`
+	Count int    `json:"count"`
+}
+
+// histogramSpec describes how the histogram buckets are laid out: either
+// explicit edges, or a number of equal-width buckets between the lowest and
+// highest score.
+type histogramSpec struct {
+	buckets int
+	edges   []*big.Rat
+}
+
+// parseHistogramSpec reads the "buckets" and "edges" query parameters.
+// Edges are a comma separated, strictly increasing list of numbers.
+func parseHistogramSpec(bucketsParam, edgesParam string) (histogramSpec, error) {
+	if bucketsParam != "" && edgesParam != "" {
+		return histogramSpec{}, errors.New("only one of buckets or edges can be given")
+	}
+
+	if edgesParam != "" {
+		parts := strings.Split(edgesParam, ",")
+		if len(parts) < 2 || len(parts) > MaximumHistogramBuckets+1 {
+			return histogramSpec{}, errors.New("edges must have between 2 and " + strconv.Itoa(MaximumHistogramBuckets+1) + " values")
+		}
+		edges := make([]*big.Rat, len(parts))
+		for i, part := range parts {
+			edge, ok := new(big.Rat).SetString(strings.TrimSpace(part))
+			if !ok {
+				return histogramSpec{}, errors.New("edges must be numbers")
+			}
+			if i > 0 && edge.Cmp(edges[i-1]) <= 0 {
+				return histogramSpec{}, errors.New("edges must be strictly increasing")
+			}
+			edges[i] = edge
+		}
+		return histogramSpec{edges: edges}, nil
+	}
+
+	buckets, err := parseLimit(bucketsParam, DefaultHistogramBuckets, MaximumHistogramBuckets)
+	if err != nil {
+		return histogramSpec{}, errors.New("buckets must be a number between 1 and " + strconv.Itoa(MaximumHistogramBuckets))
+	}
+	return histogramSpec{buckets: buckets}, nil
+}
+
+// edgesFor returns the bucket edges for scores between min and max.
+func (h histogramSpec) edgesFor(min, max int64) []*big.Rat {
+	if h.edges != nil {
+		return h.edges
+	}
+
+	lower := new(big.Rat).SetInt64(min)
+	if min == max {
+		// All scores are equal, a single bucket holds them all.
+		return []*big.Rat{lower, new(big.Rat).SetInt64(max)}
+	}
+
+	width := new(big.Rat).SetFrac(
+		new(big.Int).Sub(big.NewInt(max), big.NewInt(min)),
+		big.NewInt(int64(h.buckets)),
+	)
+	edges := make([]*big.Rat, h.buckets+1)
+	for i := range edges {
+		edges[i] = new(big.Rat).Add(lower, new(big.Rat).Mul(width, big.NewRat(int64(i), 1)))
+	}
+	// Avoid rounding surprises on the last edge.
+	edges[h.buckets] = new(big.Rat).SetInt64(max)
+	return edges
+}
+
+// scoreSummary holds the aggregates the statistics are derived from.
+type scoreSummary struct {
+	count       int
+	sum         *big.Int
+	sumSquares  *big.Int
+	min, max    int64
+	median      *big.Rat
+	percentiles []*big.Rat // In the order of reportedPercentiles
+	mode        []int64
+	edges       []*big.Rat
+	counts      []int // Scores per histogram bucket
+}
+
+// summarizeScores computes the summary of a set of scores sorted in ascending order.
+func summarizeScores(sorted []int64, histogram histogramSpec) scoreSummary {
+	summary := scoreSummary{
+		count:      len(sorted),
+		sum:        new(big.Int),
+		sumSquares: new(big.Int),
+	}
+	if len(sorted) == 0 {
+		return summary
+	}
+
+	// Sums are kept in big integers, so they can not overflow.
+	value := new(big.Int)
+	for _, s := range sorted {
+		value.SetInt64(s)
+		summary.sum.Add(summary.sum, value)
+		summary.sumSquares.Add(summary.sumSquares, value.Mul(value, value))
+	}
+
+	summary.min, summary.max = sorted[0], sorted[len(sorted)-1]
+	summary.median = calculatePercentile(sorted, 50)
+	for _, p := range reportedPercentiles {
+		summary.percentiles = append(summary.percentiles, calculatePercentile(sorted, p))
+	}
+	summary.mode = calculateMode(sorted)
+	summary.edges = histogram.edgesFor(summary.min, summary.max)
+	summary.counts = calculateHistogram(sorted, summary.edges)
+
+	return summary
+}
+
+// response converts the summary into the statistics returned to clients.
+func (s scoreSummary) response() GameStatisticsResponse {
+	response := GameStatisticsResponse{
+		Players:   s.count,
+		Mean:      "0",
+		Median:    "0",
+		Mode:      []string{"0"},
+		Min:       "0",
+		Max:       "0",
+		P25:       "0",
+		P75:       "0",
+		P90:       "0",
+		P99:       "0",
+		Variance:  "0",
+		StdDev:    "0",
+		Histogram: []HistogramBucket{},
+	}
+	if s.count == 0 {
+		return response
+	}
+
+	variance := calculateVariance(s.sum, s.sumSquares, s.count)
+
+	response.Mean = formatDecimal(calculateMean(s.sum, s.count))
+	response.Median = formatDecimal(s.median)
+	response.Min = strconv.FormatInt(s.min, 10)
+	response.Max = strconv.FormatInt(s.max, 10)
+	response.P25 = formatDecimal(s.percentiles[0])
+	response.P75 = formatDecimal(s.percentiles[1])
+	response.P90 = formatDecimal(s.percentiles[2])
+	response.P99 = formatDecimal(s.percentiles[3])
+	response.Variance = formatDecimal(variance)
+	response.StdDev = formatSquareRoot(variance)
+
+	response.Mode = make([]string, len(s.mode))
+	for i, m := range s.mode {
+		response.Mode[i] = strconv.FormatInt(m, 10)
+	}
+
+	response.Histogram = make([]HistogramBucket, len(s.counts))
+	for i, count := range s.counts {
+		response.Histogram[i] = HistogramBucket{
+			Lower: formatDecimal(s.edges[i]),
+			Upper: formatDecimal(s.edges[i+1]),
+			Count: count,
+		}
+	}
+
+	return response
+}
+
+// calculateMean, calculatePercentile, calculateVariance, calculateMode and
+// calculateHistogram are utility functions to compute statistics on scores.
+// They assume that scores are non-empty, and the ones taking a slice assume
+// that it is sorted in ascending order.
+
+func calculateMean(sum *big.Int, count int) *big.Rat {
+	return new(big.Rat).SetFrac(sum, big.NewInt(int64(count)))
+}
+
+// calculatePercentile interpolates linearly between the closest ranks, like
+// percentile_cont in SQL. The median is the 50th percentile.
+func calculatePercentile(sorted []int64, percent int64) *big.Rat {
+	lower, fraction := percentilePosition(len(sorted), percent)
+	if fraction.Sign() == 0 {
+		return new(big.Rat).SetInt64(sorted[lower])
+	}
+	return interpolate(sorted[lower], sorted[lower+1], fraction)
+}
+
+// percentilePosition returns where a percentile sits among count sorted scores:
+// fraction of the way from the score at index lower to the next one.
+func percentilePosition(count int, percent int64) (int, *big.Rat) {
+	// The percentile sits at position percent/100 * (n-1) of the sorted scores.
+	position := big.NewRat(percent*int64(count-1), 100)
+	lower := new(big.Int).Quo(position.Num(), position.Denom()).Int64()
+	return int(lower), position.Sub(position, new(big.Rat).SetInt64(lower))
+}
+
+// interpolate returns the value fraction of the way from lower to upper.
+func interpolate(lower, upper int64, fraction *big.Rat) *big.Rat {
+	result := new(big.Rat).SetInt64(lower)
+	step := new(big.Rat).SetInt(new(big.Int).Sub(big.NewInt(upper), big.NewInt(lower)))
+	return result.Add(result, step.Mul(step, fraction))
+}
+
+// calculateVariance returns the population variance, (n*Σx² - (Σx)²) / n².
+func calculateVariance(sum, sumSquares *big.Int, count int) *big.Rat {
+	n := big.NewInt(int64(count))
+	numerator := new(big.Int).Mul(n, sumSquares)
+	numerator.Sub(numerator, new(big.Int).Mul(sum, sum))
+	return new(big.Rat).SetFrac(numerator, new(big.Int).Mul(n, n))
+}
+
+// calculateMode returns the most frequent scores in ascending order, or none if
+// all scores are unique.
+func calculateMode(sorted []int64) []int64 {
+	maxFreq := 0
+	frequency := make(map[int64]int)
+	for _, score := range sorted {
+		frequency[score]++
+		if frequency[score] > maxFreq {
+			maxFreq = frequency[score]
+		}
+	}
+	mode := []int64{}
+	if maxFreq == 1 {
+		return mode // No mode if all scores are unique
+	}
+	for score, freq := range frequency {
+		if freq == maxFreq {
+			mode = append(mode, score)
+		}
+	}
+	slices.Sort(mode)
+	return mode
+}
+
+// calculateHistogram counts the scores in each bucket between consecutive edges.
+// Scores outside of the edges are not counted.
+func calculateHistogram(sorted []int64, edges []*big.Rat) []int {
+	counts := make([]int, len(edges)-1)
+	last := len(counts) - 1
+	value := new(big.Rat)
+	bucket := 0
+	for _, s := range sorted {
+		value.SetInt64(s)
+		if value.Cmp(edges[0]) < 0 {
+			continue
+		}
+		for bucket < last && value.Cmp(edges[bucket+1]) >= 0 {
+			bucket++
+		}
+		if bucket == last && value.Cmp(edges[last+1]) > 0 {
+			break
+		}
+		counts[bucket]++
+	}
+	return counts
+}
+
+// formatDecimal formats a rational as a decimal string, rounded to
+// StatisticsDecimalPlaces and without trailing zeros.
+func formatDecimal(r *big.Rat) string {
+	return trimDecimal(r.FloatString(StatisticsDecimalPlaces))
+}
+
+// formatSquareRoot formats the square root of a non-negative rational like formatDecimal.
+func formatSquareRoot(r *big.Rat) string {
+	root := new(big.Float).SetPrec(256).SetRat(r)
+	root.Sqrt(root)
+	return trimDecimal(root.Text('f', StatisticsDecimalPlaces))
+}
+
+// trimDecimal removes the trailing zeros of a decimal string, and the decimal
+// point if nothing is left after it.
+func trimDecimal(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"game-scores/ent"
+	"game-scores/ent/score"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/lib/pq"
+)
+
+// summarizeInDatabase computes the same summary as summarizeScores with
+// aggregate queries, so the scores never leave the database. It relies on the
+// ordered-set aggregates and numeric casts of Postgres.
+func summarizeInDatabase(ctx context.Context, client *ent.Client, board leaderboard, histogram histogramSpec) (scoreSummary, error) {
+	summary := scoreSummary{
+		sum:        new(big.Int),
+		sumSquares: new(big.Int),
+	}
+
+	// Count, sums and extremes come from a single pass. Sums are computed in
+	// numeric, so they can not overflow.
+	var totals []struct {
+		Count      int    `sql:"count"`
+		Sum        string `sql:"sum"`
+		SumSquares string `sql:"sum_squares"`
+		Min        int64  `sql:"min"`
+		Max        int64  `sql:"max"`
+	}
+	err := board.scores(client).
+		Modify(func(s *entsql.Selector) {
+			value := s.C(score.FieldValue)
+			s.Select(
+				entsql.As(entsql.Count("*"), "count"),
+				entsql.As(entsql.Min(value), "min"),
+				entsql.As(entsql.Max(value), "max"),
+			).
+				AppendSelectExprAs(entsql.Expr("SUM("+value+"::numeric)"), "sum").
+				AppendSelectExprAs(entsql.Expr("SUM("+value+"::numeric * "+value+")"), "sum_squares")
+		}).
+		Scan(ctx, &totals)
+	if err != nil {
+		return summary, err
+	}
+	if len(totals) == 0 || totals[0].Count == 0 {
+		return summary, nil
+	}
+
+	summary.count = totals[0].Count
+	summary.min, summary.max = totals[0].Min, totals[0].Max
+	if _, ok := summary.sum.SetString(totals[0].Sum, 10); !ok {
+		return summary, fmt.Errorf("unexpected sum %q", totals[0].Sum)
+	}
+	if _, ok := summary.sumSquares.SetString(totals[0].SumSquares, 10); !ok {
+		return summary, fmt.Errorf("unexpected sum of squares %q", totals[0].SumSquares)
+	}
+
+	if err := percentilesInDatabase(ctx, client, board, &summary); err != nil {
+		return summary, err
+	}
+
+	summary.mode, err = modeInDatabase(ctx, client, board)
+	if err != nil {
+		return summary, err
+	}
+
+	summary.edges = histogram.edgesFor(summary.min, summary.max)
+	summary.counts, err = histogramInDatabase(ctx, client, board, summary.edges)
+	if err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
+
+// percentilesInDatabase fills in the median and the reported percentiles of a
+// summary whose count is known. The scores on both sides of each percentile are
+// fetched with percentile_disc and interpolated exactly, the same way
+// calculatePercentile does, since percentile_cont works in floating point.
+func percentilesInDatabase(ctx context.Context, client *ent.Client, board leaderboard, summary *scoreSummary) error {
+	percents := append([]int64{50}, reportedPercentiles...)
+
+	// Collect the indexes of the scores the percentiles sit between.
+	var indexes []int
+	seen := make(map[int]bool)
+	for _, p := range percents {
+		lower, fraction := percentilePosition(summary.count, p)
+		for _, i := range []int{lower, lower + 1} {
+			if !seen[i] && (i == lower || fraction.Sign() != 0) {
+				seen[i] = true
+				indexes = append(indexes, i)
+			}
+		}
+	}
+
+	// percentile_disc(f) returns the score at index ceil(f*n)-1, aiming at the
+	// middle of each score keeps the index exact despite floating point.
+	fractions := make([]float64, len(indexes))
+	for i, index := range indexes {
+		fractions[i] = (float64(index) + 0.5) / float64(summary.count)
+	}
+
+	var rows []struct {
+		Values pq.Int64Array `sql:"percentiles"`
+	}
+	err := board.scores(client).
+		Modify(func(s *entsql.Selector) {
+			s.SelectExpr().AppendSelectExprAs(entsql.ExprFunc(func(b *entsql.Builder) {
+				b.WriteString("percentile_disc(").
+					Arg(pq.Float64Array(fractions)).
+					WriteString("::float8[]) WITHIN GROUP (ORDER BY ").
+					WriteString(s.C(score.FieldValue)).
+					WriteString(")")
+			}), "percentiles")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 || len(rows[0].Values) != len(indexes) {
+		return fmt.Errorf("expected %d percentile values", len(indexes))
+	}
+
+	values := make(map[int]int64, len(indexes))
+	for i, index := range indexes {
+		values[index] = rows[0].Values[i]
+	}
+
+	at := func(percent int64) *big.Rat {
+		lower, fraction := percentilePosition(summary.count, percent)
+		if fraction.Sign() == 0 {
+			return new(big.Rat).SetInt64(values[lower])
+		}
+		return interpolate(values[lower], values[lower+1], fraction)
+	}
+	summary.median = at(50)
+	summary.percentiles = summary.percentiles[:0]
+	for _, p := range reportedPercentiles {
+		summary.percentiles = append(summary.percentiles, at(p))
+	}
+	return nil
+}
+
+// modeInDatabase returns the most frequent scores on the leaderboard in
+// ascending order, or none if all scores are unique, like calculateMode.
+// mode() WITHIN GROUP picks a single value on ties, so the scores are grouped
+// instead.
+func modeInDatabase(ctx context.Context, client *ent.Client, board leaderboard) ([]int64, error) {
+	var frequencies []struct {
+		Frequency int `sql:"frequency"`
+	}
+	err := board.scores(client).
+		Modify(func(s *entsql.Selector) {
+			s.Select(entsql.As(entsql.Count("*"), "frequency")).
+				GroupBy(s.C(score.FieldValue)).
+				OrderBy(entsql.Desc("frequency")).
+				Limit(1)
+		}).
+		Scan(ctx, &frequencies)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := []int64{}
+	if len(frequencies) == 0 || frequencies[0].Frequency == 1 {
+		return mode, nil // No mode if all scores are unique
+	}
+
+	var values []struct {
+		Value int64 `sql:"value"`
+	}
+	err = board.scores(client).
+		Modify(func(s *entsql.Selector) {
+			s.Select(s.C(score.FieldValue)).
+				GroupBy(s.C(score.FieldValue)).
+				Having(entsql.P(func(b *entsql.Builder) {
+					b.WriteString("COUNT(*) = ").Arg(frequencies[0].Frequency)
+				})).
+				OrderBy(s.C(score.FieldValue))
+		}).
+		Scan(ctx, &values)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range values {
+		mode = append(mode, v.Value)
+	}
+	return mode, nil
+}
+
+// histogramInDatabase counts the scores on the leaderboard in each bucket
+// between consecutive edges, like calculateHistogram.
+func histogramInDatabase(ctx context.Context, client *ent.Client, board leaderboard, edges []*big.Rat) ([]int, error) {
+	counts := make([]int, len(edges)-1)
+	last := len(counts) - 1
+
+	var buckets []struct {
+		Bucket int `sql:"bucket"`
+		Count  int `sql:"count"`
+	}
+	err := board.scores(client).
+		Modify(func(s *entsql.Selector) {
+			value := s.C(score.FieldValue)
+			bucket := entsql.ExprFunc(func(b *entsql.Builder) {
+				b.WriteString("CASE")
+				for i := 1; i <= last; i++ {
+					b.WriteString(" WHEN ")
+					compareToEdge(b, value, "<", edges[i])
+					b.WriteString(" THEN " + strconv.Itoa(i-1))
+				}
+				b.WriteString(" ELSE " + strconv.Itoa(last) + " END")
+			})
+			s.Where(entsql.And(
+				entsql.P(func(b *entsql.Builder) { compareToEdge(b, value, ">=", edges[0]) }),
+				entsql.P(func(b *entsql.Builder) { compareToEdge(b, value, "<=", edges[last+1]) }),
+			)).
+				SelectExpr().
+				AppendSelectExprAs(bucket, "bucket").
+				AppendSelectExprAs(entsql.Expr("COUNT(*)"), "count").
+				GroupBy("bucket")
+		}).
+		Scan(ctx, &buckets)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range buckets {
+		counts[b.Bucket] = b.Count
+	}
+	return counts, nil
+}
+
+// compareToEdge writes the comparison of a score with a histogram edge. Both
+// sides are multiplied by the edge's denominator, so the comparison stays exact.
+func compareToEdge(b *entsql.Builder, value, op string, edge *big.Rat) {
+	b.WriteString(value + "::numeric * ").
+		Arg(edge.Denom().String()).
+		WriteString("::numeric " + op + " ").
+		Arg(edge.Num().String()).
+		WriteString("::numeric")
+}
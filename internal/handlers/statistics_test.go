@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+// rat parses an exact decimal or fraction, e.g. "1.75" or "7/4".
+func rat(t *testing.T, s string) *big.Rat {
+	t.Helper()
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		t.Fatalf("invalid rational %q", s)
+	}
+	return r
+}
+
+// sumsOf returns the sum and the sum of squares of scores, as summarizeScores does.
+func sumsOf(scores []int64) (*big.Int, *big.Int) {
+	sum, sumSquares := new(big.Int), new(big.Int)
+	for _, s := range scores {
+		value := big.NewInt(s)
+		sum.Add(sum, value)
+		sumSquares.Add(sumSquares, value.Mul(value, value))
+	}
+	return sum, sumSquares
+}
+
+func TestCalculatePercentile(t *testing.T) {
+	tests := []struct {
+		name    string
+		sorted  []int64
+		percent int64
+		want    string
+	}{
+		{name: "median of even count", sorted: []int64{1, 2, 3, 4}, percent: 50, want: "2.5"},
+		{name: "p25", sorted: []int64{1, 2, 3, 4}, percent: 25, want: "1.75"},
+		{name: "p75", sorted: []int64{1, 2, 3, 4}, percent: 75, want: "3.25"},
+		{name: "p90", sorted: []int64{1, 2, 3, 4}, percent: 90, want: "3.7"},
+		{name: "p99", sorted: []int64{1, 2, 3, 4}, percent: 99, want: "3.97"},
+		{name: "median of odd count", sorted: []int64{1, 2, 3, 4, 100}, percent: 50, want: "3"},
+		{name: "single score", sorted: []int64{42}, percent: 99, want: "42"},
+		{name: "ties", sorted: []int64{5, 5, 5, 9}, percent: 50, want: "5"},
+		{name: "negative scores", sorted: []int64{-10, -4}, percent: 25, want: "-8.5"},
+		{name: "largest scores", sorted: []int64{math.MaxInt64 - 1, math.MaxInt64}, percent: 50, want: "9223372036854775806.5"},
+		{name: "full range", sorted: []int64{math.MinInt64, math.MaxInt64}, percent: 50, want: "-0.5"},
+		{name: "full range p99", sorted: []int64{math.MinInt64, math.MaxInt64}, percent: 99, want: "9038904596117680290.85"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculatePercentile(tt.sorted, tt.percent); got.Cmp(rat(t, tt.want)) != 0 {
+				t.Errorf("calculatePercentile(%v, %d) = %s, want %s", tt.sorted, tt.percent, got.FloatString(6), tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateMean(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []int64
+		want   string
+	}{
+		{name: "exact", scores: []int64{1, 2, 3, 4}, want: "2.5"},
+		{name: "integer", scores: []int64{2, 4, 6}, want: "4"},
+		{name: "rounded down", scores: []int64{0, 0, 1}, want: "0.333333"},
+		{name: "rounded up", scores: []int64{0, 1, 1}, want: "0.666667"},
+		{name: "negative", scores: []int64{-1, 0, 0}, want: "-0.333333"},
+		{name: "largest scores", scores: []int64{math.MaxInt64, math.MaxInt64}, want: "9223372036854775807"},
+		{name: "largest scores, not integer", scores: []int64{math.MaxInt64, math.MaxInt64 - 1}, want: "9223372036854775806.5"},
+		{name: "smallest scores", scores: []int64{math.MinInt64, math.MinInt64}, want: "-9223372036854775808"},
+		{name: "full range", scores: []int64{math.MinInt64, math.MaxInt64}, want: "-0.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, _ := sumsOf(tt.scores)
+			if got := formatDecimal(calculateMean(sum, len(tt.scores))); got != tt.want {
+				t.Errorf("mean of %v = %q, want %q", tt.scores, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateVariance(t *testing.T) {
+	tests := []struct {
+		name       string
+		scores     []int64
+		want       string
+		wantStdDev string
+	}{
+		{name: "known dataset", scores: []int64{1, 2, 3, 4}, want: "1.25", wantStdDev: "1.118034"},
+		{name: "single score", scores: []int64{42}, want: "0", wantStdDev: "0"},
+		{name: "all scores tied", scores: []int64{7, 7, 7}, want: "0", wantStdDev: "0"},
+		{name: "integer deviation", scores: []int64{2, 4, 4, 4, 5, 5, 7, 9}, want: "4", wantStdDev: "2"},
+		{name: "largest scores tied", scores: []int64{math.MaxInt64, math.MaxInt64}, want: "0", wantStdDev: "0"},
+		{name: "largest scores", scores: []int64{math.MaxInt64 - 1, math.MaxInt64}, want: "0.25", wantStdDev: "0.5"},
+		{name: "full range", scores: []int64{math.MinInt64, math.MaxInt64}, want: "85070591730234615856620279821087277056.25", wantStdDev: "9223372036854775807.5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, sumSquares := sumsOf(tt.scores)
+			variance := calculateVariance(sum, sumSquares, len(tt.scores))
+			if variance.Cmp(rat(t, tt.want)) != 0 {
+				t.Errorf("variance of %v = %s, want %s", tt.scores, variance.FloatString(6), tt.want)
+			}
+			if got := formatSquareRoot(variance); got != tt.wantStdDev {
+				t.Errorf("standard deviation of %v = %q, want %q", tt.scores, got, tt.wantStdDev)
+			}
+		})
+	}
+}
+
+func TestCalculateMode(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []int64
+		want   []int64
+	}{
+		{name: "single mode", sorted: []int64{1, 2, 2, 3}, want: []int64{2}},
+		{name: "several modes", sorted: []int64{1, 1, 2, 4, 4}, want: []int64{1, 4}},
+		{name: "all scores unique", sorted: []int64{1, 2, 3}, want: []int64{}},
+		{name: "single score", sorted: []int64{42}, want: []int64{}},
+		{name: "all scores tied", sorted: []int64{7, 7, 7}, want: []int64{7}},
+		{name: "extreme scores", sorted: []int64{math.MinInt64, math.MinInt64, 0, math.MaxInt64, math.MaxInt64}, want: []int64{math.MinInt64, math.MaxInt64}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculateMode(tt.sorted); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("calculateMode(%v) = %v, want %v", tt.sorted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateHistogram(t *testing.T) {
+	tests := []struct {
+		name      string
+		sorted    []int64
+		histogram histogramSpec
+		wantEdges []string
+		want      []int
+	}{
+		{
+			name:      "equal-width buckets",
+			sorted:    []int64{0, 5, 5, 9, 15, 20, 21},
+			histogram: histogramSpec{buckets: 2},
+			wantEdges: []string{"0", "10.5", "21"},
+			want:      []int{4, 3},
+		},
+		{
+			name:      "last bucket is inclusive",
+			sorted:    []int64{1, 2, 3, 4},
+			histogram: histogramSpec{buckets: 3},
+			wantEdges: []string{"1", "2", "3", "4"},
+			want:      []int{1, 1, 2},
+		},
+		{
+			name:      "explicit edges leave scores out",
+			sorted:    []int64{0, 5, 5, 9, 15, 20, 21},
+			histogram: histogramSpec{edges: []*big.Rat{big.NewRat(1, 1), big.NewRat(9, 2), big.NewRat(20, 1)}},
+			wantEdges: []string{"1", "4.5", "20"},
+			want:      []int{0, 5},
+		},
+		{
+			name:      "all scores tied",
+			sorted:    []int64{7, 7, 7},
+			histogram: histogramSpec{buckets: 3},
+			wantEdges: []string{"7", "7"},
+			want:      []int{3},
+		},
+		{
+			name:      "largest scores",
+			sorted:    []int64{math.MaxInt64 - 2, math.MaxInt64 - 1, math.MaxInt64},
+			histogram: histogramSpec{buckets: 2},
+			wantEdges: []string{"9223372036854775805", "9223372036854775806", "9223372036854775807"},
+			want:      []int{1, 2},
+		},
+		{
+			name:      "full range",
+			sorted:    []int64{math.MinInt64, -1, 0, math.MaxInt64},
+			histogram: histogramSpec{buckets: 2},
+			wantEdges: []string{"-9223372036854775808", "-0.5", "9223372036854775807"},
+			want:      []int{2, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edges := tt.histogram.edgesFor(tt.sorted[0], tt.sorted[len(tt.sorted)-1])
+			gotEdges := make([]string, len(edges))
+			for i, edge := range edges {
+				gotEdges[i] = formatDecimal(edge)
+			}
+			if !reflect.DeepEqual(gotEdges, tt.wantEdges) {
+				t.Fatalf("edgesFor() = %v, want %v", gotEdges, tt.wantEdges)
+			}
+			if got := calculateHistogram(tt.sorted, edges); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("calculateHistogram(%v) = %v, want %v", tt.sorted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	tests := []struct {
+		name string
+		r    *big.Rat
+		want string
+	}{
+		{name: "integer", r: big.NewRat(4, 1), want: "4"},
+		{name: "zero", r: new(big.Rat), want: "0"},
+		{name: "trailing zeros", r: big.NewRat(5, 2), want: "2.5"},
+		{name: "rounded down", r: big.NewRat(1, 3), want: "0.333333"},
+		{name: "rounded up", r: big.NewRat(2, 3), want: "0.666667"},
+		{name: "rounded to an integer", r: big.NewRat(19999999, 10000000), want: "2"},
+		{name: "negative", r: big.NewRat(-1, 3), want: "-0.333333"},
+		{name: "negative rounded to zero", r: big.NewRat(-1, 1000000000), want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatDecimal(tt.r); got != tt.want {
+				t.Errorf("formatDecimal(%s) = %q, want %q", tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeScores(t *testing.T) {
+	got := summarizeScores([]int64{1, 2, 3, 4}, histogramSpec{buckets: 2}).response()
+	want := GameStatisticsResponse{
+		Players:  4,
+		Mean:     "2.5",
+		Median:   "2.5",
+		Mode:     []string{},
+		Min:      "1",
+		Max:      "4",
+		P25:      "1.75",
+		P75:      "3.25",
+		P90:      "3.7",
+		P99:      "3.97",
+		Variance: "1.25",
+		StdDev:   "1.118034",
+		Histogram: []HistogramBucket{
+			{Lower: "1", Upper: "2.5", Count: 2},
+			{Lower: "2.5", Upper: "4", Count: 2},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("summarizeScores().response() = %+v, want %+v", got, want)
+	}
+
+	empty := summarizeScores(nil, histogramSpec{buckets: DefaultHistogramBuckets}).response()
+	if empty.Players != 0 || empty.Mean != "0" || len(empty.Histogram) != 0 {
+		t.Errorf("summarizeScores(nil).response() = %+v, want zero statistics", empty)
+	}
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/internal/events"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// ScoreStreamHeartbeat is how often a comment is sent on idle score streams,
+	// so proxies and clients do not time out the connection.
+	ScoreStreamHeartbeat = 15 * time.Second
+	// ScoreStreamRetry is how long clients wait before reconnecting to a dropped stream.
+	ScoreStreamRetry = 3 * time.Second
+)
+
+// Leaderboard event types sent on score streams.
+const (
+	// ScoreEventJoin is sent when a player joins a game.
+	ScoreEventJoin = "join"
+	// ScoreEventUpdate is sent when a player's score changes.
+	ScoreEventUpdate = "score"
+	// ScoreEventReset is sent when a resumed stream missed events, or when a score
+	// was removed, clients should reload the leaderboard.
+	ScoreEventReset = "reset"
+)
+
+// ScoreEvent defines the shape of the data of join and score events.
+type ScoreEvent struct {
+	Username string `json:"username"`
+	Score    string `json:"score"`
+	Rank     int    `json:"rank"`
+}
+
+// StreamGameScores streams the changes to a game's leaderboard as Server-Sent
+// Events. Clients resume with the Last-Event-ID header, or the last_event_id
+// query parameter, and receive the events they missed first.
+func (h *GameScoresHandler) StreamGameScores(w http.ResponseWriter, r *http.Request) {
+
+	// Get the game ID from the URL parameter.
+	gameIDStr := chi.URLParam(r, "gameID")
+	gameID, err := strconv.Atoi(gameIDStr)
+	if err != nil {
+		http.Error(w, "Invalid game ID format", http.StatusBadRequest)
+		return
+	}
+
+	exists, err := h.Database.Game.Query().Where(game.ID(gameID)).Exist(r.Context())
+	if err != nil {
+		log.Printf("Failed to check for game %d: %v", gameID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !exists {
+		http.Error(w, "Game not found", http.StatusNotFound)
+		return
+	}
+
+	if h.Events == nil {
+		http.Error(w, "Score streams are not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+
+	subscription, replay, complete := h.Events.Subscribe(gameID, lastEventID)
+	defer subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no") // Disable response buffering in nginx
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+
+	fmt.Fprintf(w, "retry: %d\n\n", ScoreStreamRetry.Milliseconds())
+	if !complete {
+		// The missed events are gone, the client has to start over from the latest one.
+		replay = []events.Event{{ID: subscription.Latest, Type: ScoreEventReset, Data: []byte("{}")}}
+	}
+	for _, e := range replay {
+		writeEvent(w, e)
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(ScoreStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e, ok := <-subscription.Events:
+			if !ok {
+				// Dropped for falling behind, the client reconnects and resumes.
+				return
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes an event in the Server-Sent Events format.
+func writeEvent(w io.Writer, e events.Event) error {
+	_, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+	return err
+}
+
+// publishScore announces a player's new score on the game's stream, with their
+// rank on the leaderboard the score belongs to. Streams are best effort, so
+// failures are only logged.
+func (h *GameScoresHandler) publishScore(ctx context.Context, board leaderboard, eventType, username string, s *ent.Score) {
+	if h.Events == nil {
+		return
+	}
+
+	rank, err := board.rankOf(ctx, h.Database, s.Value)
+	if err != nil {
+		log.Printf("Failed to rank score %d for the stream of game %d: %v", s.ID, board.gameID, err)
+		return
+	}
+
+	data, err := json.Marshal(ScoreEvent{
+		Username: username,
+		Score:    strconv.FormatInt(s.Value, 10),
+		Rank:     rank,
+	})
+	if err != nil {
+		log.Printf("Failed to encode score event for game %d: %v", board.gameID, err)
+		return
+	}
+
+	h.Events.Publish(board.gameID, eventType, data)
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/predicate"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	"game-scores/internal/decoder"
+	auth_middleware "game-scores/internal/middleware"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokenRequest defines the shape of the request body for refreshing tokens.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// LogoutRequest defines the shape of the logout request body. With AllDevices
+// every session of the user is ended, not only the one of the given token.
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+	AllDevices   bool   `json:"all_devices,omitempty"`
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new
+// refresh token. Each refresh token can only be used once: reusing one means it
+// was copied, so the whole session is revoked.
+func (h *UserHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var req RefreshTokenRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode refresh token request: %v", err)
+		return
+	}
+
+	if req.RefreshToken == "" {
+		http.Error(w, "Refresh token is required", http.StatusBadRequest)
+		return
+	}
+
+	current, err := h.Database.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(auth.HashRefreshToken(req.RefreshToken))).
+		WithUser().
+		Only(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "Invalid refresh token", http.StatusUnauthorized)
+			return
+		}
+		log.Printf("Failed to query refresh token: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	now := time.Now()
+	if !current.ExpiresAt.After(now) {
+		http.Error(w, "Refresh token expired", http.StatusUnauthorized)
+		return
+	}
+	if rejectBanned(w, current.Edges.User) {
+		return
+	}
+
+	var response LoginResponse
+	reused := false
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		// Mark the token as used, only one request can succeed even if the
+		// token is presented several times at once.
+		rotated, err := tx.RefreshToken.
+			Update().
+			Where(refreshtoken.ID(current.ID), refreshtoken.RevokedAtIsNil()).
+			SetRevokedAt(now).
+			Save(r.Context())
+		if err != nil {
+			return err
+		}
+		if rotated == 0 {
+			reused = true
+			return nil
+		}
+
+		response, err = h.issueTokens(r.Context(), tx.Client(), current.Edges.User, current.Family, current.Device, current.TwoFactor)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to rotate refresh token: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if reused {
+		log.Printf("Refresh token reuse detected for user %s, revoking session", current.Edges.User.Username)
+		if err := revokeRefreshTokens(r.Context(), h.Database, refreshtoken.Family(current.Family)); err != nil {
+			log.Printf("Failed to revoke session: %v", err)
+		}
+		http.Error(w, "Refresh token has already been used", http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// Logout revokes the session of a refresh token, together with the access token
+// sent in the Authorization header, if any. With AllDevices every session and
+// access token of the user is revoked instead.
+func (h *UserHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var req LogoutRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode logout request: %v", err)
+		return
+	}
+
+	if req.RefreshToken == "" {
+		http.Error(w, "Refresh token is required", http.StatusBadRequest)
+		return
+	}
+
+	current, err := h.Database.RefreshToken.
+		Query().
+		Where(refreshtoken.TokenHash(auth.HashRefreshToken(req.RefreshToken))).
+		WithUser().
+		Only(r.Context())
+	if ent.IsNotFound(err) {
+		// Nothing to revoke, the client is logged out either way.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to query refresh token: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	session := refreshtoken.Family(current.Family)
+	if req.AllDevices {
+		session = refreshtoken.HasUserWith(user.ID(current.Edges.User.ID))
+	}
+	if err := revokeRefreshTokens(r.Context(), h.Database, session); err != nil {
+		log.Printf("Failed to revoke refresh tokens: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if h.Revocations != nil {
+		if req.AllDevices {
+			err = h.Revocations.RevokeUser(r.Context(), current.Edges.User.ID)
+		} else if claims, parseErr := auth.ParseJWT(auth_middleware.BearerToken(r), h.Keys); parseErr == nil && claims.UserID == current.Edges.User.ID {
+			err = h.Revocations.RevokeToken(r.Context(), claims, "logout")
+		}
+		if err != nil {
+			log.Printf("Failed to revoke access tokens: %v", err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RevokeUserTokens ends every session of a user and revokes all the access
+// tokens issued to them, e.g. after a stolen session. Unlike a ban, the user can
+// log in again.
+func (h *UserHandler) RevokeUserTokens(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	target, ok := h.outrankedUser(w, r, claims)
+	if !ok {
+		return
+	}
+
+	if err := revokeRefreshTokens(r.Context(), h.Database, refreshtoken.HasUserWith(user.ID(target.ID))); err != nil {
+		log.Printf("Failed to revoke refresh tokens of %s: %v", target.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if h.Revocations != nil {
+		if err := h.Revocations.RevokeUser(r.Context(), target.ID); err != nil {
+			log.Printf("Failed to revoke access tokens of %s: %v", target.Username, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	log.Printf("User %s revoked the tokens of %s", claims.Username, target.Username)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// issueTokens creates an access token and a refresh token in the given token
+// family for a user. twoFactor tells whether the session logged in with a
+// second factor, which its refreshed tokens keep.
+func (h *UserHandler) issueTokens(ctx context.Context, client *ent.Client, u *ent.User, family uuid.UUID, device string, twoFactor bool) (LoginResponse, error) {
+	accessToken, err := auth.GenerateJWT(u, h.Keys, h.AccessTokenTTL, twoFactor)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	refreshToken, hash, err := auth.NewRefreshToken()
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	err = client.RefreshToken.
+		Create().
+		SetUser(u).
+		SetTokenHash(hash).
+		SetFamily(family).
+		SetDevice(device).
+		SetTwoFactor(twoFactor).
+		SetExpiresAt(time.Now().Add(h.RefreshTokenTTL)).
+		Exec(ctx)
+	if err != nil {
+		return LoginResponse{}, err
+	}
+
+	return LoginResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(h.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// revokeRefreshTokens revokes the refresh tokens matching a predicate that are
+// not revoked yet.
+func revokeRefreshTokens(ctx context.Context, client *ent.Client, tokens predicate.RefreshToken) error {
+	return client.RefreshToken.
+		Update().
+		Where(tokens, refreshtoken.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx)
+}
@@ -0,0 +1,466 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/recoverycode"
+	"game-scores/ent/refreshtoken"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	"game-scores/internal/decoder"
+	auth_middleware "game-scores/internal/middleware"
+	"game-scores/internal/validate"
+
+	"github.com/google/uuid"
+)
+
+// EnrollTwoFactorRequest defines the shape of the request body for starting the
+// two-factor enrollment of the logged-in user.
+type EnrollTwoFactorRequest struct {
+	Password string `json:"password"`
+}
+
+// TwoFactorEnrollmentResponse defines the shape of a started enrollment. The
+// secret is added to an authenticator app, typed in or through a QR code of
+// the otpauth:// URI.
+type TwoFactorEnrollmentResponse struct {
+	Secret string `json:"secret"`
+	URI    string `json:"otpauth_uri"`
+}
+
+// ConfirmTwoFactorRequest defines the shape of the request body for confirming
+// an enrollment with the first code of the authenticator app.
+type ConfirmTwoFactorRequest struct {
+	Code string `json:"code"`
+}
+
+// ConfirmTwoFactorResponse defines the shape of a confirmed enrollment: the
+// recovery codes, only shown once, and the tokens of a new session that logged
+// in with two factors.
+type ConfirmTwoFactorResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	LoginResponse
+}
+
+// SecondFactorRequest defines the shape of the request body of the actions
+// requiring a second factor, either a TOTP code or an unused recovery code.
+type SecondFactorRequest struct {
+	Code         string `json:"code,omitempty"`
+	RecoveryCode string `json:"recovery_code,omitempty"`
+}
+
+// DisableTwoFactorRequest defines the shape of the request body for disabling
+// the two-factor authentication of the logged-in user.
+type DisableTwoFactorRequest struct {
+	Password string `json:"password"`
+	SecondFactorRequest
+}
+
+// RecoveryCodesResponse defines the shape of a new set of recovery codes.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorLoginRequest defines the shape of the request body completing the
+// login of a user with two-factor authentication.
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+	SecondFactorRequest
+}
+
+// errTwoFactorChanged is returned when the two-factor settings of a user
+// changed while confirming them.
+var errTwoFactorChanged = errors.New("two-factor authentication changed concurrently")
+
+// LoginTwoFactor completes the login of a user with two-factor authentication,
+// exchanging the challenge token of their accepted password and a TOTP or
+// recovery code for the tokens of a new session. Wrong codes count as failed
+// logins, so they are throttled like wrong passwords.
+func (h *UserHandler) LoginTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req TwoFactorLoginRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode two-factor login request: %v", err)
+		return
+	}
+
+	if req.ChallengeToken == "" {
+		http.Error(w, "Challenge token is required", http.StatusBadRequest)
+		return
+	}
+	if req.Code == "" && req.RecoveryCode == "" {
+		http.Error(w, "Either a code or a recovery code is required", http.StatusBadRequest)
+		return
+	}
+
+	claims, err := auth.ParseTwoFactorChallenge(req.ChallengeToken, h.Keys)
+	if err != nil {
+		http.Error(w, "Invalid or expired challenge token", http.StatusUnauthorized)
+		return
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		http.Error(w, "Invalid or expired challenge token", http.StatusUnauthorized)
+		return
+	}
+
+	u, err := h.Database.User.Get(r.Context(), userID)
+	if ent.IsNotFound(err) || (err == nil && !u.TotpEnabled) {
+		// The account was deleted, or two-factor authentication disabled, since.
+		http.Error(w, "Invalid or expired challenge token", http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to query user %s: %v", userID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if rejectBanned(w, u) {
+		return
+	}
+
+	ip, username := clientIP(r), validate.UsernameKey(u.Username)
+	attempt, ok := h.reserveLogin(w, r, username, ip)
+	if !ok {
+		return
+	}
+	defer releaseLogin(r, attempt)
+
+	valid, err := verifySecondFactor(r.Context(), h.Database, u, req.SecondFactorRequest)
+	if err != nil {
+		log.Printf("Failed to verify the second factor of %s: %v", u.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !valid {
+		h.rejectLogin(w, r, attempt, username, ip, "invalid_two_factor_code", "Invalid two-factor code")
+		return
+	}
+	if req.Code == "" {
+		log.Printf("User %s logged in with a recovery code", u.Username)
+	}
+
+	h.loginSucceeded(w, r, u, claims.Device, true)
+}
+
+// EnrollTwoFactor starts the two-factor enrollment of the logged-in user, after
+// checking their password, with a new TOTP secret. Logins only require a code
+// once the enrollment is confirmed with one. Starting again replaces the secret.
+func (h *UserHandler) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req EnrollTwoFactorRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode two-factor enrollment request: %v", err)
+		return
+	}
+
+	u, ok := h.reauthenticate(w, r, req.Password)
+	if !ok {
+		return
+	}
+	if u.TotpEnabled {
+		http.Error(w, "Two-factor authentication is already enabled", http.StatusConflict)
+		return
+	}
+
+	secret, err := auth.NewTOTPSecret()
+	if err != nil {
+		log.Printf("Failed to generate TOTP secret: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = h.Database.User.
+		UpdateOne(u).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Exec(r.Context())
+	if err != nil {
+		log.Printf("Failed to start the two-factor enrollment of %s: %v", u.Username, err)
+		http.Error(w, "Failed to start two-factor enrollment", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TwoFactorEnrollmentResponse{
+		Secret: secret,
+		URI:    auth.TOTPURI(secret, h.TOTPIssuer, u.Username),
+	})
+}
+
+// ConfirmTwoFactor enables the two-factor authentication of the logged-in user
+// with the first code of their authenticator app, and hands out their recovery
+// codes. Every session of the user ends, and a new one that logged in with two
+// factors is started for the caller.
+func (h *UserHandler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmTwoFactorRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode two-factor confirmation request: %v", err)
+		return
+	}
+
+	u, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+	if u.TotpEnabled {
+		http.Error(w, "Two-factor authentication is already enabled", http.StatusConflict)
+		return
+	}
+	if u.TotpSecret == nil {
+		http.Error(w, "Two-factor enrollment has not been started", http.StatusConflict)
+		return
+	}
+
+	step, valid := auth.ValidateTOTP(*u.TotpSecret, req.Code, time.Now())
+	if !valid {
+		http.Error(w, "Invalid two-factor code", http.StatusForbidden)
+		return
+	}
+
+	codes, hashes, err := auth.NewRecoveryCodes()
+	if err != nil {
+		log.Printf("Failed to generate recovery codes: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		// Only the secret the code was checked against is enabled, not one a
+		// concurrent enrollment replaced it with.
+		enabled, err := tx.User.
+			Update().
+			Where(user.ID(u.ID), user.TotpEnabled(false), user.TotpSecretEQ(*u.TotpSecret)).
+			SetTotpEnabled(true).
+			SetTotpLastStep(step).
+			Save(r.Context())
+		if err != nil {
+			return err
+		}
+		if enabled == 0 {
+			return errTwoFactorChanged
+		}
+
+		if err := replaceRecoveryCodes(r.Context(), tx.Client(), u, hashes); err != nil {
+			return err
+		}
+		return revokeRefreshTokens(r.Context(), tx.Client(), refreshtoken.HasUserWith(user.ID(u.ID)))
+	})
+	if errors.Is(err, errTwoFactorChanged) {
+		http.Error(w, "Two-factor enrollment changed, start it again", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to enable the two-factor authentication of %s: %v", u.Username, err)
+		http.Error(w, "Failed to enable two-factor authentication", http.StatusInternalServerError)
+		return
+	}
+	if h.Revocations != nil {
+		if err := h.Revocations.RevokeUser(r.Context(), u.ID); err != nil {
+			log.Printf("Failed to revoke access tokens of %s: %v", u.Username, err)
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	response, err := h.issueTokens(r.Context(), h.Database, u, uuid.New(), "", true)
+	if err != nil {
+		log.Printf("Failed to issue tokens: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s enabled two-factor authentication", u.Username)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(ConfirmTwoFactorResponse{RecoveryCodes: codes, LoginResponse: response})
+}
+
+// DisableTwoFactor disables the two-factor authentication of the logged-in
+// user, after checking their password and a second factor. Roles that require
+// two-factor authentication can not disable it.
+func (h *UserHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req DisableTwoFactorRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode disable two-factor request: %v", err)
+		return
+	}
+
+	u, ok := h.reauthenticate(w, r, req.Password)
+	if !ok {
+		return
+	}
+	if auth.RequiresTwoFactor(string(u.Role)) {
+		http.Error(w, "The "+string(u.Role)+" role requires two-factor authentication", http.StatusForbidden)
+		return
+	}
+	if !u.TotpEnabled {
+		http.Error(w, "Two-factor authentication is not enabled", http.StatusConflict)
+		return
+	}
+
+	valid, err := verifySecondFactor(r.Context(), h.Database, u, req.SecondFactorRequest)
+	if err != nil {
+		log.Printf("Failed to verify the second factor of %s: %v", u.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !valid {
+		http.Error(w, "Invalid two-factor code", http.StatusForbidden)
+		return
+	}
+
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		err := tx.User.
+			UpdateOne(u).
+			ClearTotpSecret().
+			SetTotpEnabled(false).
+			SetTotpLastStep(0).
+			Exec(r.Context())
+		if err != nil {
+			return err
+		}
+		_, err = tx.RecoveryCode.Delete().Where(recoverycode.HasUserWith(user.ID(u.ID))).Exec(r.Context())
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to disable the two-factor authentication of %s: %v", u.Username, err)
+		http.Error(w, "Failed to disable two-factor authentication", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s disabled two-factor authentication", u.Username)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the logged-in user,
+// after checking a second factor, e.g. once most of them are used.
+func (h *UserHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	var req SecondFactorRequest
+
+	err := decoder.DecodeJSONBody(w, r, &req)
+	if err != nil {
+		log.Printf("Failed to decode recovery codes request: %v", err)
+		return
+	}
+
+	u, ok := h.currentUser(w, r)
+	if !ok {
+		return
+	}
+	if !u.TotpEnabled {
+		http.Error(w, "Two-factor authentication is not enabled", http.StatusConflict)
+		return
+	}
+
+	valid, err := verifySecondFactor(r.Context(), h.Database, u, req)
+	if err != nil {
+		log.Printf("Failed to verify the second factor of %s: %v", u.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !valid {
+		http.Error(w, "Invalid two-factor code", http.StatusForbidden)
+		return
+	}
+
+	codes, hashes, err := auth.NewRecoveryCodes()
+	if err != nil {
+		log.Printf("Failed to generate recovery codes: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	err = withTx(r.Context(), h.Database, func(tx *ent.Tx) error {
+		return replaceRecoveryCodes(r.Context(), tx.Client(), u, hashes)
+	})
+	if err != nil {
+		log.Printf("Failed to replace the recovery codes of %s: %v", u.Username, err)
+		http.Error(w, "Failed to replace recovery codes", http.StatusInternalServerError)
+		return
+	}
+
+	log.Printf("User %s replaced their recovery codes", u.Username)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// verifySecondFactor checks the TOTP code or recovery code of a user with
+// two-factor authentication. Either can only be used once: a TOTP code must
+// belong to a later time step than the last one accepted, and a recovery code
+// is marked as used.
+func verifySecondFactor(ctx context.Context, client *ent.Client, u *ent.User, req SecondFactorRequest) (bool, error) {
+	if !u.TotpEnabled || u.TotpSecret == nil {
+		return false, nil
+	}
+
+	if req.Code != "" {
+		step, valid := auth.ValidateTOTP(*u.TotpSecret, req.Code, time.Now())
+		if !valid {
+			return false, nil
+		}
+		// Only one request can accept a code, even if it is sent several times at once.
+		accepted, err := client.User.
+			Update().
+			Where(user.ID(u.ID), user.TotpLastStepLT(step)).
+			SetTotpLastStep(step).
+			Save(ctx)
+		return accepted > 0, err
+	}
+
+	if req.RecoveryCode != "" {
+		used, err := client.RecoveryCode.
+			Update().
+			Where(
+				recoverycode.CodeHash(auth.HashRecoveryCode(req.RecoveryCode)),
+				recoverycode.HasUserWith(user.ID(u.ID)),
+				recoverycode.UsedAtIsNil(),
+			).
+			SetUsedAt(time.Now()).
+			Save(ctx)
+		return used > 0, err
+	}
+	return false, nil
+}
+
+// replaceRecoveryCodes deletes the recovery codes of a user and stores new ones.
+func replaceRecoveryCodes(ctx context.Context, client *ent.Client, u *ent.User, hashes []string) error {
+	if _, err := client.RecoveryCode.Delete().Where(recoverycode.HasUserWith(user.ID(u.ID))).Exec(ctx); err != nil {
+		return err
+	}
+	return client.RecoveryCode.MapCreateBulk(hashes, func(c *ent.RecoveryCodeCreate, i int) {
+		c.SetUser(u).SetCodeHash(hashes[i])
+	}).Exec(ctx)
+}
+
+// currentUser loads the logged-in user, writing the error response when it fails.
+func (h *UserHandler) currentUser(w http.ResponseWriter, r *http.Request) (*ent.User, bool) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	u, err := h.Database.User.Get(r.Context(), claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return nil, false
+		}
+		log.Printf("Failed to query user %s: %v", claims.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return nil, false
+	}
+	return u, true
+}
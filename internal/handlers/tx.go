@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"game-scores/ent"
+)
+
+// withTx runs fn inside a database transaction, committing it if fn succeeds
+// and rolling it back if fn returns an error or panics.
+func withTx(ctx context.Context, client *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -1,30 +1,60 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
+	"time"
 
 	"game-scores/ent"
 	"game-scores/ent/user"
 	"game-scores/internal/auth"
 	"game-scores/internal/decoder"
+	"game-scores/internal/mail"
+	"game-scores/internal/validate"
 
-	"golang.org/x/crypto/bcrypt"
+	"github.com/google/uuid"
 )
 
 const (
 	// MinimumPasswordLength is the minimum length for user passwords.
 	MinimumPasswordLength = 8
-	MinumumUsernameLength = 3
-	MaximumUsernameLength = 64
+	// MaximumDeviceLength limits the device label of a login.
+	MaximumDeviceLength = 64
 )
 
 // UserHandler holds dependencies for user-related handlers.
 type UserHandler struct {
-	Database  *ent.Client
-	JWTSecret []byte
+	Database *ent.Client
+	// Keys signs the access tokens.
+	Keys *auth.KeySet
+	// AccessTokenTTL and RefreshTokenTTL are how long issued tokens are valid.
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	// Revocations keeps the access tokens that must no longer be accepted.
+	Revocations *auth.RevocationStore
+	// Mailer sends the verification emails, which link to PublicURL, the address
+	// users reach the API at. Links are valid for EmailVerificationTTL.
+	Mailer               mail.Mailer
+	PublicURL            string
+	EmailVerificationTTL time.Duration
+	// PasswordResetTTL is how long emailed password reset tokens are valid.
+	PasswordResetTTL time.Duration
+	// Passwords hashes new passwords, and verifies them against stored hashes.
+	Passwords auth.PasswordHasher
+	// Logins throttles failed logins, unless it is nil.
+	Logins *auth.LoginLimiter
+	// TOTPIssuer names the API in authenticator apps. Users with two-factor
+	// authentication have TwoFactorChallengeTTL to enter their code once their
+	// password was accepted.
+	TOTPIssuer            string
+	TwoFactorChallengeTTL time.Duration
+	// OIDCProviders are the OpenID Connect providers users log in with, by name.
+	// Users have OIDCLoginTTL to log in at the provider.
+	OIDCProviders map[string]*auth.OIDCProvider
+	OIDCLoginTTL  time.Duration
 }
 
 // RegisterRequest defines the shape of the registration request body.
@@ -35,14 +65,30 @@ type RegisterRequest struct {
 }
 
 // LoginRequest defines the shape of the login request body.
+// Device is an optional label of the device logging in, shown to the user
+// when managing their sessions.
 type LoginRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	Device   string `json:"device,omitempty"`
 }
 
-// LoginResponse defines the shape of the successful login response.
+// LoginResponse defines the shape of the successful login response. Token is the
+// short-lived access token, RefreshToken is exchanged for new tokens at
+// POST /token/refresh once it expires.
 type LoginResponse struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"` // Seconds until the access token expires
+}
+
+// TwoFactorChallengeResponse defines the shape of the login response of users
+// with two-factor authentication. ChallengeToken is exchanged for the tokens at
+// POST /login/2fa together with a TOTP or recovery code, within ExpiresIn seconds.
+type TwoFactorChallengeResponse struct {
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	ChallengeToken    string `json:"challenge_token"`
+	ExpiresIn         int    `json:"expires_in"`
 }
 
 // Register handles user creation.
@@ -54,24 +100,26 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate the username length
-	if len(req.Username) < MinumumUsernameLength {
-		http.Error(w, "Username must be at least "+strconv.Itoa(MinumumUsernameLength)+" characters long", http.StatusBadRequest)
-		return
+	// Validate every field, so all errors can be shown at once
+	fields := make(map[string]string)
+	username, usernameKey, err := validate.Username(req.Username)
+	if err != nil {
+		fields["username"] = err.Error()
 	}
-	if len(req.Username) > MaximumUsernameLength {
-		http.Error(w, "Username must not exceed "+strconv.Itoa(MaximumUsernameLength)+" characters", http.StatusBadRequest)
-		return
+	email, err := validate.Email(req.Email)
+	if err != nil {
+		fields["email"] = err.Error()
 	}
-
-	// Validate the password length
 	if len(req.Password) < MinimumPasswordLength {
-		http.Error(w, "Password must be at least "+strconv.Itoa(MinimumPasswordLength)+" characters long", http.StatusBadRequest)
+		fields["password"] = "Password must be at least " + strconv.Itoa(MinimumPasswordLength) + " characters long"
+	}
+	if len(fields) > 0 {
+		writeValidationErrors(w, http.StatusBadRequest, "Invalid registration", fields)
 		return
 	}
 
 	// Hash the user's password for security
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPassword, err := h.Passwords.Hash(req.Password)
 	if err != nil {
 		log.Printf("Failed to hash password: %v", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
@@ -81,14 +129,16 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	// Create user in the database using the Ent client
 	newUser, err := h.Database.User.
 		Create().
-		SetUsername(req.Username).
-		SetEmail(req.Email).
-		SetPasswordHash(string(hashedPassword)).
+		SetUsername(username).
+		SetUsernameKey(usernameKey).
+		SetUsernameSkeleton(validate.UsernameSkeleton(username)).
+		SetEmail(email).
+		SetPasswordHash(hashedPassword).
 		Save(r.Context())
 
 	if ent.IsConstraintError(err) {
 		log.Printf("User already exists: %v", err)
-		http.Error(w, "User already exists", http.StatusConflict)
+		h.rejectTakenRegistration(w, r, username, usernameKey, email)
 		return
 	}
 	if err != nil {
@@ -98,11 +148,48 @@ func (h *UserHandler) Register(w http.ResponseWriter, r *http.Request) {
 	}
 
 	log.Printf("User registered successfully: %s", newUser.Username)
+
+	// The account works without a verified email, so a failure is only logged.
+	if err := h.sendVerificationEmail(r.Context(), newUser); err != nil {
+		log.Printf("Failed to send the verification email to %s: %v", newUser.Username, err)
+	}
+
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(map[string]string{"message": "User registered successfully"})
 }
 
-// Login handles user authentication and JWT issuance.
+// rejectTakenRegistration rejects a registration whose username or email is
+// already taken, or whose username looks like a taken one, telling which one.
+func (h *UserHandler) rejectTakenRegistration(w http.ResponseWriter, r *http.Request, username, usernameKey, email string) {
+	fields := make(map[string]string)
+	taken, err := h.Database.User.Query().Where(user.UsernameKey(usernameKey)).Exist(r.Context())
+	if err != nil {
+		log.Printf("Failed to query user: %v", err)
+	}
+	if taken {
+		fields["username"] = "Username is already taken"
+	} else {
+		taken, err = h.Database.User.Query().Where(user.UsernameSkeleton(validate.UsernameSkeleton(username))).Exist(r.Context())
+		if err != nil {
+			log.Printf("Failed to query user: %v", err)
+		}
+		if taken {
+			fields["username"] = "Username looks too much like a taken username"
+		}
+	}
+	taken, err = h.Database.User.Query().Where(user.Email(email)).Exist(r.Context())
+	if err != nil {
+		log.Printf("Failed to query user: %v", err)
+	}
+	if taken {
+		fields["email"] = "Email is already in use"
+	}
+	writeValidationErrors(w, http.StatusConflict, "User already exists", fields)
+}
+
+// Login handles user authentication and JWT issuance. Users with two-factor
+// authentication get a challenge token instead, exchanged for the tokens at
+// POST /login/2fa.
 func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
 
@@ -112,16 +199,34 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if len(req.Device) > MaximumDeviceLength {
+		http.Error(w, "Device must not exceed "+strconv.Itoa(MaximumDeviceLength)+" characters", http.StatusBadRequest)
+		return
+	}
+
+	// Usernames are looked up and throttled by their key, so "Admin" and "admin"
+	// are the same user.
+	username := validate.UsernameKey(req.Username)
+
+	// Refuse attempts that come too soon after failed ones, even with the right
+	// password. The attempt is recorded first, so concurrent guesses count too.
+	ip := clientIP(r)
+	attempt, ok := h.reserveLogin(w, r, username, ip)
+	if !ok {
+		return
+	}
+	defer releaseLogin(r, attempt)
+
 	// Find the user by username in the database
 	foundUser, err := h.Database.User.
 		Query().
-		Where(user.UsernameEQ(req.Username)).
+		Where(user.UsernameKey(username)).
 		Only(r.Context())
 
 	if err != nil {
 		// If user is not found, return a generic unauthorized error
 		if ent.IsNotFound(err) {
-			http.Error(w, "Invalid username or password", http.StatusUnauthorized)
+			h.loginFailed(w, r, attempt, username, ip)
 			return
 		}
 		log.Printf("Failed to query user: %v", err)
@@ -130,20 +235,93 @@ func (h *UserHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Compare the provided password with the stored hash
-	if err := bcrypt.CompareHashAndPassword([]byte(foundUser.PasswordHash), []byte(req.Password)); err != nil {
-		http.Error(w, "Invalid username or password", http.StatusUnauthorized)
+	valid, err := h.Passwords.Verify(foundUser.PasswordHash, req.Password)
+	if err != nil {
+		log.Printf("Failed to verify the password of %s: %v", foundUser.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if !valid {
+		h.loginFailed(w, r, attempt, username, ip)
+		return
+	}
+	h.rehashPassword(r.Context(), foundUser, req.Password)
+	if rejectBanned(w, foundUser) {
+		return
+	}
+
+	// Users with two-factor authentication still have to enter a code, failed
+	// logins are only cleared once they did.
+	if foundUser.TotpEnabled {
+		h.writeTwoFactorChallenge(w, foundUser, req.Device)
 		return
 	}
 
-	// If password is correct, generate a JWT
-	tokenString, err := auth.GenerateJWT(foundUser, h.JWTSecret)
+	h.loginSucceeded(w, r, foundUser, req.Device, false)
+}
+
+// writeTwoFactorChallenge responds to the first step of the login of a user
+// with two-factor authentication, with the challenge token exchanged for the
+// tokens at POST /login/2fa.
+func (h *UserHandler) writeTwoFactorChallenge(w http.ResponseWriter, u *ent.User, device string) {
+	challenge, err := auth.GenerateTwoFactorChallenge(u, device, h.Keys, h.TwoFactorChallengeTTL)
 	if err != nil {
-		log.Printf("Failed to generate JWT: %v", err)
+		log.Printf("Failed to issue two-factor challenge: %v", err)
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TwoFactorChallengeResponse{
+		TwoFactorRequired: true,
+		ChallengeToken:    challenge,
+		ExpiresIn:         int(h.TwoFactorChallengeTTL.Seconds()),
+	})
+}
+
+// loginSucceeded clears the failed logins of a user who logged in, and starts
+// a new session with its own token family.
+func (h *UserHandler) loginSucceeded(w http.ResponseWriter, r *http.Request, u *ent.User, device string, twoFactor bool) {
+	if h.Logins != nil {
+		if err := h.Logins.RecordSuccess(r.Context(), validate.UsernameKey(u.Username)); err != nil {
+			log.Printf("Failed to clear the failed logins of %s: %v", u.Username, err)
+		}
+	}
 
-	// Send the token back to the client
+	response, err := h.issueTokens(r.Context(), h.Database, u, uuid.New(), device, twoFactor)
+	if err != nil {
+		log.Printf("Failed to issue tokens: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Send the tokens back to the client
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(LoginResponse{Token: tokenString})
+	json.NewEncoder(w).Encode(response)
+}
+
+// rehashPassword replaces the outdated password hash of a user who just entered
+// their password, e.g. a bcrypt hash or an argon2id hash with weaker parameters,
+// so hashes are upgraded without resetting passwords. The password is only
+// replaced if it did not change meanwhile. A failure is only logged, the user
+// is upgraded at their next login.
+func (h *UserHandler) rehashPassword(ctx context.Context, u *ent.User, password string) {
+	if !h.Passwords.NeedsRehash(u.PasswordHash) {
+		return
+	}
+
+	hashedPassword, err := h.Passwords.Hash(password)
+	if err != nil {
+		log.Printf("Failed to rehash the password of %s: %v", u.Username, err)
+		return
+	}
+	err = h.Database.User.
+		Update().
+		Where(user.ID(u.ID), user.PasswordHash(u.PasswordHash)).
+		SetPasswordHash(hashedPassword).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("Failed to store the rehashed password of %s: %v", u.Username, err)
+		return
+	}
+	log.Printf("Rehashed the outdated password hash of %s", u.Username)
 }
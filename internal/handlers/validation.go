@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ValidationErrorResponse defines the shape of the response to a request with
+// invalid fields. Fields maps each invalid field to what is wrong with it, so
+// clients can show every error next to its field at once.
+type ValidationErrorResponse struct {
+	Message string            `json:"message"`
+	Fields  map[string]string `json:"fields"`
+}
+
+// writeValidationErrors rejects a request with the errors of its fields.
+func writeValidationErrors(w http.ResponseWriter, status int, message string, fields map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ValidationErrorResponse{Message: message, Fields: fields})
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"game-scores/ent"
+	"game-scores/ent/user"
+	"game-scores/internal/auth"
+	mailer "game-scores/internal/mail"
+	auth_middleware "game-scores/internal/middleware"
+)
+
+// VerifyEmail confirms the email of a user through the token of the link sent
+// to them. Following a link again once verified succeeds too.
+func (h *UserHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, "Verification token is required", http.StatusBadRequest)
+		return
+	}
+
+	claims, err := auth.ParseEmailVerificationToken(token, h.Keys)
+	if err != nil {
+		http.Error(w, "Invalid or expired verification token", http.StatusBadRequest)
+		return
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		http.Error(w, "Invalid or expired verification token", http.StatusBadRequest)
+		return
+	}
+
+	// Only the email the token was sent to is verified, not one changed to since.
+	updated, err := h.Database.User.
+		Update().
+		Where(user.ID(userID), user.EmailEQ(claims.Email)).
+		SetEmailVerified(true).
+		Save(r.Context())
+	if err != nil {
+		log.Printf("Failed to verify the email of user %s: %v", userID, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if updated == 0 {
+		http.Error(w, "Invalid or expired verification token", http.StatusBadRequest)
+		return
+	}
+
+	log.Printf("Email verified for user %s", userID)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"message": "Email verified successfully"})
+}
+
+// ResendVerificationEmail sends a new verification email to the logged-in user.
+func (h *UserHandler) ResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth_middleware.ClaimsFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+		return
+	}
+
+	u, err := h.Database.User.Get(r.Context(), claims.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			http.Error(w, "User not found", http.StatusNotFound)
+			return
+		}
+		log.Printf("Failed to query user %s: %v", claims.Username, err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	if u.EmailVerified {
+		http.Error(w, "Email is already verified", http.StatusConflict)
+		return
+	}
+
+	if err := h.sendVerificationEmail(r.Context(), u); err != nil {
+		log.Printf("Failed to send the verification email to %s: %v", u.Username, err)
+		http.Error(w, "Failed to send the verification email", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"message": "Verification email sent"})
+}
+
+// sendVerificationEmail sends a user the link verifying their current email.
+func (h *UserHandler) sendVerificationEmail(ctx context.Context, u *ent.User) error {
+	if h.Mailer == nil {
+		return nil
+	}
+
+	token, err := auth.GenerateEmailVerificationToken(u, h.Keys, h.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	link := strings.TrimSuffix(h.PublicURL, "/") + "/verify-email?token=" + url.QueryEscape(token)
+
+	return h.Mailer.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease verify your email by opening the link below:\n\n%s\n\n"+
+			"If you did not create an account, you can ignore this email.\n",
+			u.Username, link),
+	})
+}
@@ -0,0 +1,108 @@
+// Package mail sends the emails of the API, such as address verifications,
+// through an SMTP server or, for development and tests, to files or the log.
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// format renders the message with its headers, as sent over SMTP.
+func (m Message) format(from string, date time.Time) ([]byte, error) {
+	// Header values must not carry line breaks, or they could add headers.
+	for _, value := range []string{from, m.To, m.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errors.New("mail headers must not contain line breaks")
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", m.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	return []byte(b.String()), nil
+}
+
+// SMTPMailer sends emails through an SMTP server, using STARTTLS when the server
+// supports it.
+type SMTPMailer struct {
+	// Addr is the host:port of the server.
+	Addr string
+	// From is the sender address.
+	From string
+	// Auth authenticates with the server, nil when it needs no authentication.
+	Auth smtp.Auth
+}
+
+// Send sends the message. net/smtp does not take a context, so sending is not
+// cancelled with it.
+func (m *SMTPMailer) Send(_ context.Context, msg Message) error {
+	data, err := msg.format(m.From, time.Now())
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(m.Addr, m.Auth, m.From, []string{msg.To}, data)
+}
+
+// FileMailer writes each email to its own file in a directory instead of sending
+// it, named after the recipient and the time it was sent, so they can be read in
+// development and tests.
+type FileMailer struct {
+	Dir  string
+	From string
+
+	mu sync.Mutex // Serializes the writes
+}
+
+// Send writes the message to a new file of the directory.
+func (m *FileMailer) Send(_ context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	data, err := msg.format(m.From, now)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return err
+	}
+
+	// The recipient is a single address, checked by format, so it is safe in a file name.
+	name := filepath.Join(m.Dir, fmt.Sprintf("%s-%d.eml", filepath.Base(msg.To), now.UnixNano()))
+	return os.WriteFile(name, data, 0o644)
+}
+
+// LogMailer logs emails instead of sending them.
+type LogMailer struct{}
+
+// Send logs the message.
+func (LogMailer) Send(_ context.Context, msg Message) error {
+	slog.Info("Email not sent, logging it instead", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
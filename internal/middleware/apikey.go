@@ -0,0 +1,89 @@
+package api_middleware
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/serverkey"
+	"game-scores/internal/auth"
+)
+
+// APIKeyHeader is the header game servers send their server key in.
+const APIKeyHeader = "X-API-Key"
+
+const ServerKeyContextKey = contextKey("server_key")
+
+// lastUsedPrecision is how stale the last use of a server key may be, so busy
+// servers do not write to the database on every request.
+const lastUsedPrecision = time.Minute
+
+// APIKeyMiddleware authenticates game servers by the server key of the
+// X-API-Key header and passes the key, with its game, down to the handler.
+// Handlers check the key's permissions and game themselves.
+func APIKeyMiddleware(db *ent.Client) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			apiKey := r.Header.Get(APIKeyHeader)
+			if apiKey == "" {
+				http.Error(w, "X-API-Key header required", http.StatusUnauthorized)
+				return
+			}
+
+			key, err := db.ServerKey.
+				Query().
+				Where(serverkey.KeyHash(auth.HashAPIKey(apiKey))).
+				WithGame().
+				Only(r.Context())
+			if err != nil {
+				if ent.IsNotFound(err) {
+					http.Error(w, "Invalid API key", http.StatusUnauthorized)
+					return
+				}
+				log.Printf("Failed to query server key: %v", err)
+				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				return
+			}
+			if key.RevokedAt != nil {
+				http.Error(w, "API key has been revoked", http.StatusUnauthorized)
+				return
+			}
+
+			now := time.Now()
+			if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > lastUsedPrecision {
+				if err := db.ServerKey.UpdateOne(key).SetLastUsedAt(now).Exec(r.Context()); err != nil {
+					log.Printf("Failed to record the use of server key %d: %v", key.ID, err)
+				}
+			}
+
+			// Key is valid. Add it to context and call the next handler.
+			ctx := context.WithValue(r.Context(), ServerKeyContextKey, key)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AuthOrAPIKeyMiddleware authenticates game servers with APIKeyMiddleware when
+// the request has an X-API-Key header, and players with AuthMiddleware otherwise.
+func AuthOrAPIKeyMiddleware(keys *auth.KeySet, revocations *auth.RevocationStore, db *ent.Client) func(http.Handler) http.Handler {
+	playerAuth := AuthMiddleware(keys, revocations)
+	serverAuth := APIKeyMiddleware(db)
+	return func(next http.Handler) http.Handler {
+		players, servers := playerAuth(next), serverAuth(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(APIKeyHeader) != "" {
+				servers.ServeHTTP(w, r)
+				return
+			}
+			players.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ServerKeyFromContext is a helper function to get the server key from the context.
+func ServerKeyFromContext(ctx context.Context) (*ent.ServerKey, bool) {
+	key, ok := ctx.Value(ServerKeyContextKey).(*ent.ServerKey)
+	return key, ok
+}
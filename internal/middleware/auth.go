@@ -2,12 +2,11 @@ package api_middleware
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"strings"
 
 	"game-scores/internal/auth"
-
-	"github.com/golang-jwt/jwt/v5"
 )
 
 type contextKey string
@@ -15,27 +14,37 @@ type contextKey string
 const ClaimsContextKey = contextKey("claims")
 
 // AuthMiddleware verifies the JWT and passes the claims down to the handler.
-func AuthMiddleware(jwtSecret []byte) func(http.Handler) http.Handler {
+// Tokens revoked in the revocation store are rejected, unless it is nil.
+func AuthMiddleware(keys *auth.KeySet, revocations *auth.RevocationStore) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
+			tokenString := BearerToken(r)
+			if tokenString == "" {
 				http.Error(w, "Authorization header required", http.StatusUnauthorized)
 				return
 			}
 
-			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-			claims := &auth.JWTClaims{}
-
-			token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-				return jwtSecret, nil
-			})
+			claims, err := auth.ParseJWT(tokenString, keys)
 
-			if err != nil || !token.Valid {
+			// Tokens without an ID can not be revoked, so they are not trusted.
+			if err != nil || claims.ID == "" {
 				http.Error(w, "Invalid token", http.StatusUnauthorized)
 				return
 			}
 
+			if revocations != nil {
+				revoked, err := revocations.IsRevoked(r.Context(), claims)
+				if err != nil {
+					log.Printf("Failed to check token revocation: %v", err)
+					http.Error(w, "Internal server error", http.StatusInternalServerError)
+					return
+				}
+				if revoked {
+					http.Error(w, "Token has been revoked", http.StatusUnauthorized)
+					return
+				}
+			}
+
 			// Token is valid. Add claims to context and call the next handler.
 			ctx := context.WithValue(r.Context(), ClaimsContextKey, claims)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -43,6 +52,12 @@ func AuthMiddleware(jwtSecret []byte) func(http.Handler) http.Handler {
 	}
 }
 
+// BearerToken returns the token of the request's Authorization header, or an
+// empty string if there is none.
+func BearerToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+}
+
 // ClaimsFromContext is a helper function to get claims from the context.
 func ClaimsFromContext(ctx context.Context) (*auth.JWTClaims, bool) {
 	claims, ok := ctx.Value(ClaimsContextKey).(*auth.JWTClaims)
@@ -0,0 +1,87 @@
+package api_middleware
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"game-scores/ent"
+	"game-scores/internal/auth"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// RequirePermission only lets the request through when the role of the logged-in
+// user was granted one of the permissions. Game servers need their server key to
+// be granted one of them, on the game of the route's gameID parameter. Users
+// whose role requires two-factor authentication are refused until they log in
+// with it.
+//
+// It must come after AuthMiddleware or AuthOrAPIKeyMiddleware.
+func RequirePermission(permissions ...string) func(http.Handler) http.Handler {
+	return requirePermission(nil, permissions)
+}
+
+// RequireGamePermission is RequirePermission for routes of a single game, which
+// also lets the owner and maintainers of the route's game through when their
+// role on the game was granted one of the permissions.
+func RequireGamePermission(db *ent.Client, permissions ...string) func(http.Handler) http.Handler {
+	return requirePermission(db, permissions)
+}
+
+// requirePermission checks the permissions of the request, including the roles
+// on the route's game when db is not nil.
+func requirePermission(db *ent.Client, permissions []string) func(http.Handler) http.Handler {
+	forbidden := "Forbidden: This action requires the " + strings.Join(permissions, " or ") + " permission"
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gameID, gameErr := strconv.Atoi(chi.URLParam(r, "gameID"))
+
+			if key, isServer := ServerKeyFromContext(r.Context()); isServer {
+				if gameErr == nil {
+					for _, permission := range permissions {
+						if auth.ServerKeyAllows(key, gameID, permission) {
+							next.ServeHTTP(w, r)
+							return
+						}
+					}
+				}
+				http.Error(w, forbidden, http.StatusForbidden)
+				return
+			}
+
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				http.Error(w, "Could not retrieve user claims", http.StatusInternalServerError)
+				return
+			}
+			if auth.RequiresTwoFactor(claims.Role) && !claims.TwoFactor {
+				http.Error(w, "Forbidden: The "+claims.Role+" role requires logging in with two-factor authentication", http.StatusForbidden)
+				return
+			}
+			for _, permission := range permissions {
+				if auth.HasPermission(claims.Role, permission) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			if db != nil && gameErr == nil {
+				gameRole, err := auth.GameRole(r.Context(), db, claims.UserID, gameID)
+				if err != nil {
+					log.Printf("Failed to find the role of %s on game %d: %v", claims.Username, gameID, err)
+					http.Error(w, "Internal server error", http.StatusInternalServerError)
+					return
+				}
+				for _, permission := range permissions {
+					if auth.HasGamePermission(gameRole, permission) {
+						next.ServeHTTP(w, r)
+						return
+					}
+				}
+			}
+			http.Error(w, forbidden, http.StatusForbidden)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package api_middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// Headers reverse proxies set to the address of the client they forward.
+const (
+	ForwardedForHeader = "X-Forwarded-For"
+	RealIPHeader       = "X-Real-IP"
+)
+
+// ParseTrustedProxies parses a comma separated list of IP addresses and CIDR
+// ranges, e.g. "10.0.0.0/8,192.168.1.10".
+func ParseTrustedProxies(list string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR range %q", entry)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// RealIP replaces the remote address of requests coming from a trusted proxy
+// with the address of the client it forwards: the last address of
+// X-Forwarded-For that is not a trusted proxy, or else X-Real-IP. The headers
+// of other requests are ignored, since clients could set them to anything, e.g.
+// to dodge the login lockout of their IP. Without trusted proxies, requests
+// are left as they are.
+func RealIP(trusted []*net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if len(trusted) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isTrustedProxy(trusted, remoteIP(r.RemoteAddr)) {
+				if ip := forwardedIP(trusted, r.Header); ip != nil {
+					r.RemoteAddr = ip.String()
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedIP returns the client address forwarded by trusted proxies, or nil
+// when the headers do not hold a valid one.
+func forwardedIP(trusted []*net.IPNet, header http.Header) net.IP {
+	// Each proxy appends the address it got the request from, so the addresses
+	// are read from the right, and the first one that is not a trusted proxy is
+	// the client. The ones further left were sent by the client itself.
+	var hops []string
+	for _, value := range header.Values(ForwardedForHeader) {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	if len(hops) > 0 {
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				return nil
+			}
+			if i == 0 || !isTrustedProxy(trusted, ip) {
+				return ip
+			}
+		}
+	}
+	return net.ParseIP(strings.TrimSpace(header.Get(RealIPHeader)))
+}
+
+// remoteIP returns the IP address of a remote address, with or without a port.
+func remoteIP(addr string) net.IP {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return net.ParseIP(addr)
+}
+
+// isTrustedProxy reports whether ip belongs to one of the trusted proxies.
+func isTrustedProxy(trusted []*net.IPNet, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
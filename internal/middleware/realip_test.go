@@ -0,0 +1,89 @@
+package api_middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRealIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies("10.0.0.0/8, 192.168.1.10, fd00::/8")
+	if err != nil {
+		t.Fatalf("ParseTrustedProxies() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		trusted      bool // Whether the proxies are trusted at all
+		remoteAddr   string
+		forwardedFor []string
+		realIP       string
+		want         string
+	}{
+		{name: "no proxy", trusted: true, remoteAddr: "203.0.113.7:1234", want: "203.0.113.7:1234"},
+		{name: "untrusted client sets the headers", trusted: true, remoteAddr: "203.0.113.7:1234", forwardedFor: []string{"198.51.100.1"}, realIP: "198.51.100.2", want: "203.0.113.7:1234"},
+		{name: "no trusted proxies", remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"198.51.100.1"}, want: "10.0.0.1:1234"},
+		{name: "trusted proxy", trusted: true, remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"198.51.100.1"}, want: "198.51.100.1"},
+		{name: "trusted proxy by address", trusted: true, remoteAddr: "192.168.1.10:1234", forwardedFor: []string{"198.51.100.1"}, want: "198.51.100.1"},
+		{name: "address not trusted", trusted: true, remoteAddr: "192.168.1.11:1234", forwardedFor: []string{"198.51.100.1"}, want: "192.168.1.11:1234"},
+		{name: "spoofed addresses on the left", trusted: true, remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"1.2.3.4, 198.51.100.1"}, want: "198.51.100.1"},
+		{name: "chain of trusted proxies", trusted: true, remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"1.2.3.4, 198.51.100.1, 10.0.0.2", "192.168.1.10"}, want: "198.51.100.1"},
+		{name: "only trusted proxies", trusted: true, remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"10.0.0.3, 10.0.0.2"}, want: "10.0.0.3"},
+		{name: "invalid forwarded address", trusted: true, remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"198.51.100.1, garbage"}, want: "10.0.0.1:1234"},
+		{name: "X-Real-IP", trusted: true, remoteAddr: "10.0.0.1:1234", realIP: "198.51.100.2", want: "198.51.100.2"},
+		{name: "X-Forwarded-For before X-Real-IP", trusted: true, remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"198.51.100.1"}, realIP: "198.51.100.2", want: "198.51.100.1"},
+		{name: "IPv6", trusted: true, remoteAddr: "[fd00::1]:1234", forwardedFor: []string{"2001:db8::1"}, want: "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxies := trusted
+			if !tt.trusted {
+				proxies = nil
+			}
+			var got string
+			handler := RealIP(proxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.RemoteAddr
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				r.Header.Add(ForwardedForHeader, value)
+			}
+			if tt.realIP != "" {
+				r.Header.Set(RealIPHeader, tt.realIP)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+			if got != tt.want {
+				t.Errorf("RemoteAddr = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    string
+		want    int
+		wantErr bool
+	}{
+		{name: "empty", list: ""},
+		{name: "addresses and ranges", list: "10.0.0.0/8,192.168.1.10, ::1", want: 3},
+		{name: "trailing comma", list: "10.0.0.0/8,", want: 1},
+		{name: "invalid address", list: "10.0.0.256", wantErr: true},
+		{name: "invalid range", list: "10.0.0.0/33", wantErr: true},
+		{name: "hostname", list: "proxy.example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTrustedProxies(tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTrustedProxies(%q) error = %v, wantErr %v", tt.list, err, tt.wantErr)
+			}
+			if len(got) != tt.want {
+				t.Errorf("ParseTrustedProxies(%q) = %v, want %d proxies", tt.list, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package api_middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"game-scores/ent"
+	"game-scores/ent/game"
+	"game-scores/ent/requestnonce"
+	"game-scores/internal/auth"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Headers of signed score submissions.
+const (
+	SignatureHeader          = "X-Signature"
+	SignatureTimestampHeader = "X-Signature-Timestamp" // Unix time in seconds
+	SignatureNonceHeader     = "X-Signature-Nonce"
+)
+
+const (
+	// DefaultSignatureMaxAge is how far the timestamp of a signed request may be
+	// from the server's clock, in either direction.
+	DefaultSignatureMaxAge = 5 * time.Minute
+	// MinimumNonceLength and MaximumNonceLength bound the nonce of signed requests.
+	MinimumNonceLength = 16
+	MaximumNonceLength = 128
+	// maxSignedBodyBytes matches the body limit of the JSON decoder.
+	maxSignedBodyBytes = 1_048_576
+)
+
+// SignedRequestMiddleware requires the score submissions of games with a signing
+// secret to be signed with it. The signature covers the method, path, body,
+// timestamp and nonce, requests whose timestamp is more than maxAge away are
+// rejected, and each nonce is only accepted once per game, so captured requests
+// can not be replayed. Games without a secret are not affected, and neither are
+// game servers, which authenticate with their own server key.
+//
+// It needs the gameID URL parameter, so it must be added to the routes with With.
+func SignedRequestMiddleware(db *ent.Client, maxAge time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, isServer := ServerKeyFromContext(r.Context()); isServer {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Invalid IDs and unknown games are left to the handler.
+			gameID, err := strconv.Atoi(chi.URLParam(r, "gameID"))
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			g, err := db.Game.
+				Query().
+				Where(game.ID(gameID)).
+				Select(game.FieldSigningSecret).
+				Only(r.Context())
+			if ent.IsNotFound(err) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if err != nil {
+				log.Printf("Failed to check for game %d: %v", gameID, err)
+				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				return
+			}
+			if g.SigningSecret == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			signature := r.Header.Get(SignatureHeader)
+			timestamp := r.Header.Get(SignatureTimestampHeader)
+			nonce := r.Header.Get(SignatureNonceHeader)
+			if signature == "" || timestamp == "" || nonce == "" {
+				http.Error(w, "Request signature required, this game only accepts signed scores", http.StatusUnauthorized)
+				return
+			}
+
+			seconds, err := strconv.ParseInt(timestamp, 10, 64)
+			if err != nil {
+				http.Error(w, "Invalid signature timestamp", http.StatusUnauthorized)
+				return
+			}
+			signedAt := time.Unix(seconds, 0)
+			if skew := time.Since(signedAt); skew > maxAge || skew < -maxAge {
+				http.Error(w, "Signature timestamp is too old or in the future", http.StatusUnauthorized)
+				return
+			}
+			if len(nonce) < MinimumNonceLength || len(nonce) > MaximumNonceLength {
+				http.Error(w, "Signature nonce must be between "+strconv.Itoa(MinimumNonceLength)+" and "+strconv.Itoa(MaximumNonceLength)+" characters", http.StatusUnauthorized)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSignedBodyBytes))
+			if err != nil {
+				http.Error(w, "Request body too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			if !auth.VerifyRequestSignature(signature, g.SigningSecret, timestamp, nonce, r.Method, r.URL.Path, body) {
+				http.Error(w, "Invalid request signature", http.StatusUnauthorized)
+				return
+			}
+
+			// Only requests with a valid signature use up their nonce. The nonce is
+			// kept for as long as its timestamp would be accepted.
+			err = db.RequestNonce.
+				Create().
+				SetNonce(nonce).
+				SetGameID(gameID).
+				SetExpiresAt(signedAt.Add(maxAge)).
+				Exec(r.Context())
+			if ent.IsConstraintError(err) {
+				http.Error(w, "Signature nonce has already been used", http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				log.Printf("Failed to record signature nonce for game %d: %v", gameID, err)
+				http.Error(w, "Internal server error", http.StatusInternalServerError)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// PurgeExpiredNonces deletes the nonces of signed requests whose timestamps are
+// no longer accepted, so they can not be replayed anymore anyway.
+func PurgeExpiredNonces(ctx context.Context, db *ent.Client) (int, error) {
+	return db.RequestNonce.
+		Delete().
+		Where(requestnonce.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+}
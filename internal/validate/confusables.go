@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables maps the characters usernames may contain that look like other
+// ones to their prototype, as in the confusables.txt data of Unicode Technical
+// Standard #39. It lists the ASCII digits and lowercase letters of the scripts
+// usernames may use that pass for Latin letters, skeletons are built from
+// username keys, which are case folded already.
+var confusables = map[rune]string{
+	// Latin
+	'0': "o",
+	'1': "l",
+	'm': "rn",
+	'ı': "i", // Dotless i
+	'ɑ': "a", // Alpha
+	'ɡ': "g", // Script g
+	'ɩ': "i", // Iota
+	'ȷ': "j", // Dotless j
+
+	// Cyrillic
+	'а': "a",
+	'с': "c",
+	'ԁ': "d",
+	'е': "e",
+	'һ': "h",
+	'і': "i",
+	'ј': "j",
+	'ӏ': "l", // Palochka
+	'о': "o",
+	'р': "p",
+	'ԛ': "q",
+	'ѕ': "s",
+	'у': "y",
+	'ԝ': "w",
+	'х': "x",
+
+	// Greek
+	'α': "a",
+	'ϲ': "c", // Lunate sigma
+	'ι': "i",
+	'ϳ': "j", // Yot
+	'ο': "o",
+	'ρ': "p",
+	'ν': "v",
+	'γ': "y",
+
+	// Armenian
+	'հ': "h",
+	'ո': "n",
+	'օ': "o",
+	'ս': "u",
+}
+
+// UsernameSkeleton returns the skeleton of a username, as defined by Unicode
+// Technical Standard #39: usernames that look alike share a skeleton, e.g. the
+// Latin "cope" and the Cyrillic "соре", or "player1" and "playerl". It is built
+// from the username key, so it also ignores case.
+func UsernameSkeleton(username string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(UsernameKey(username)) {
+		if prototype, ok := confusables[r]; ok {
+			b.WriteString(prototype)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFD.String(b.String())
+}
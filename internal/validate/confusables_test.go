@@ -0,0 +1,29 @@
+package validate
+
+import "testing"
+
+func TestUsernameSkeleton(t *testing.T) {
+	tests := []struct {
+		a, b string
+		same bool
+	}{
+		{a: "cope", b: "соре", same: true}, // Cyrillic
+		{a: "Cope", b: "СОРЕ", same: true},
+		{a: "polo", b: "ροlο", same: true}, // Greek
+		{a: "player1", b: "playerl", same: true},
+		{a: "b0b", b: "bob", same: true},
+		{a: "modern", b: "rnodern", same: true},
+		{a: "café", b: "café", same: true},
+		{a: "cope", b: "cape"},
+		{a: "café", b: "cafe"},
+		{a: "player1", b: "player2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			a, b := UsernameSkeleton(tt.a), UsernameSkeleton(tt.b)
+			if (a == b) != tt.same {
+				t.Errorf("UsernameSkeleton(%q) = %q, UsernameSkeleton(%q) = %q, want same = %v", tt.a, a, tt.b, b, tt.same)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Package validate normalizes and checks the usernames and emails users
+// register with, so look-alike names can not impersonate other players.
+package validate
+
+import (
+	"errors"
+	"net/mail"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MinimumUsernameLength and MaximumUsernameLength bound the number of
+	// characters of a username, once normalized.
+	MinimumUsernameLength = 3
+	MaximumUsernameLength = 64
+	// MaximumEmailLength is the longest email that can be delivered (RFC 5321).
+	MaximumEmailLength = 254
+)
+
+// ReservedUsernames can not be registered, whatever their case or separators,
+// since players would take them for the staff or the API itself.
+var ReservedUsernames = []string{
+	"admin", "administrator", "moderator", "mod", "gameowner", "owner",
+	"system", "root", "support", "staff", "official", "security",
+	"gamescores", "api", "me", "null", "undefined", "anonymous", "deleted",
+}
+
+// usernameSeparators may appear between the letters and digits of a username.
+const usernameSeparators = "_-."
+
+// scripts are the scripts the letters of usernames may belong to.
+var scripts = map[string]*unicode.RangeTable{
+	"Latin":      unicode.Latin,
+	"Greek":      unicode.Greek,
+	"Cyrillic":   unicode.Cyrillic,
+	"Armenian":   unicode.Armenian,
+	"Georgian":   unicode.Georgian,
+	"Hebrew":     unicode.Hebrew,
+	"Arabic":     unicode.Arabic,
+	"Devanagari": unicode.Devanagari,
+	"Bengali":    unicode.Bengali,
+	"Tamil":      unicode.Tamil,
+	"Thai":       unicode.Thai,
+	"Hangul":     unicode.Hangul,
+	"Hiragana":   unicode.Hiragana,
+	"Katakana":   unicode.Katakana,
+	"Bopomofo":   unicode.Bopomofo,
+	"Han":        unicode.Han,
+}
+
+// scriptCombinations are the scripts a username may mix, the "highly
+// restrictive" level of Unicode Technical Standard #39: Latin with Japanese,
+// Chinese or Korean. Any other username is written in a single script, so e.g.
+// a Cyrillic "а" can not stand in for a Latin "a".
+var scriptCombinations = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+var (
+	errUsernameCharacters = errors.New("Username may only contain letters, digits, and \"_\", \"-\" or \".\" between them")
+	errUsernameScripts    = errors.New("Username must not mix letters of different scripts")
+	errUsernameReserved   = errors.New("Username is reserved")
+	errEmail              = errors.New("Email must be a valid email address")
+)
+
+// Username normalizes a username with NFKC and checks it against the username
+// policy. It returns the normalized username, shown to other players, and the
+// key it is unique by. Errors are sentences meant for the user.
+func Username(username string) (string, string, error) {
+	username = norm.NFKC.String(username)
+
+	length := utf8.RuneCountInString(username)
+	if length < MinimumUsernameLength {
+		return "", "", errors.New("Username must be at least " + strconv.Itoa(MinimumUsernameLength) + " characters long")
+	}
+	if length > MaximumUsernameLength {
+		return "", "", errors.New("Username must not exceed " + strconv.Itoa(MaximumUsernameLength) + " characters")
+	}
+
+	used := make(map[string]bool)
+	var previous rune
+	for i, r := range username {
+		switch {
+		case unicode.IsLetter(r):
+			script := scriptOf(r)
+			if script == "" {
+				return "", "", errUsernameCharacters
+			}
+			used[script] = true
+		case r >= '0' && r <= '9':
+			// Only ASCII digits, the digits of other scripts look alike.
+		case unicode.Is(unicode.M, r):
+			// Combining marks only follow the letter they are part of.
+			if !unicode.IsLetter(previous) && !unicode.Is(unicode.M, previous) {
+				return "", "", errUsernameCharacters
+			}
+		case strings.ContainsRune(usernameSeparators, r):
+			// Separators only go between letters and digits, one at a time.
+			if i == 0 || i == len(username)-1 || strings.ContainsRune(usernameSeparators, previous) {
+				return "", "", errUsernameCharacters
+			}
+		default:
+			return "", "", errUsernameCharacters
+		}
+		previous = r
+	}
+	if !allowedScripts(used) {
+		return "", "", errUsernameScripts
+	}
+
+	key := UsernameKey(username)
+	if isReserved(username) {
+		return "", "", errUsernameReserved
+	}
+	return username, key, nil
+}
+
+// UsernameKey returns the key a username is unique by and looked up by: its
+// NFKC normalization, case folded. "Admin", "ADMIN" and "ａｄｍｉｎ" share a key.
+func UsernameKey(username string) string {
+	return norm.NFKC.String(cases.Fold().String(norm.NFKC.String(username)))
+}
+
+// Email checks that an email is a single bare address, e.g. "a@b.c" but not
+// "A <a@b.c>", and returns it lowercased, so emails are unique whatever their case.
+func Email(email string) (string, error) {
+	if len(email) > MaximumEmailLength {
+		return "", errEmail
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", errEmail
+	}
+	return NormalizeEmail(email), nil
+}
+
+// NormalizeEmail returns the form an email is stored and looked up by, without
+// checking it.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(email)
+}
+
+// scriptOf returns the script of a letter, or an empty string if usernames can
+// not use it.
+func scriptOf(r rune) string {
+	for name, table := range scripts {
+		if unicode.Is(table, r) {
+			return name
+		}
+	}
+	return ""
+}
+
+// allowedScripts reports whether a username may use letters of all the scripts.
+func allowedScripts(used map[string]bool) bool {
+	if len(used) <= 1 {
+		return true
+	}
+	for _, combination := range scriptCombinations {
+		allowed := true
+		for script := range used {
+			if !slices.Contains(combination, script) {
+				allowed = false
+				break
+			}
+		}
+		if allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// isReserved reports whether a username is a reserved name, or looks like one,
+// ignoring case and separators: "m0derat0r" passes for "moderator".
+func isReserved(username string) bool {
+	key := withoutSeparators(UsernameKey(username))
+	skeleton := withoutSeparators(UsernameSkeleton(username))
+	for _, reserved := range ReservedUsernames {
+		if key == reserved || skeleton == UsernameSkeleton(reserved) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutSeparators removes the separators of a username.
+func withoutSeparators(username string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(usernameSeparators, r) {
+			return -1
+		}
+		return r
+	}, username)
+}
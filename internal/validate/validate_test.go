@@ -0,0 +1,32 @@
+package validate
+
+import "testing"
+
+func TestUsernameReserved(t *testing.T) {
+	tests := []struct {
+		username string
+		reserved bool
+	}{
+		{username: "admin", reserved: true},
+		{username: "Mod_Erator", reserved: true},
+		{username: "ＡＤＭＩＮ", reserved: true},
+		{username: "m0derat0r", reserved: true},
+		{username: "r00t", reserved: true},
+		{username: "supp0rt", reserved: true},
+		{username: "0fficial", reserved: true},
+		{username: "0wner", reserved: true},
+		{username: "adrnin", reserved: true},
+		{username: "аdmin"}, // Mixed scripts, rejected for that instead
+		{username: "admins"},
+		{username: "rooted"},
+		{username: "player1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.username, func(t *testing.T) {
+			_, _, err := Username(tt.username)
+			if got := err == errUsernameReserved; got != tt.reserved {
+				t.Errorf("Username(%q) error = %v, want reserved = %v", tt.username, err, tt.reserved)
+			}
+		})
+	}
+}